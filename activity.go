@@ -0,0 +1,131 @@
+package gojira
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// activity params passed as is rather than as a stream filter
+var activityPlainParams = map[string]bool{
+	"maxResults":    true,
+	"title":         true,
+	"providers":     true,
+	"os_authType":   true,
+	"relativeLinks": true,
+}
+
+// operators of the stream filters, IS being implied when none is given
+var activityOperators = []string{"IS ", "NOT ", "BEFORE ", "AFTER ", "BETWEEN "}
+
+// ActivityAfter returns an "update-date" filter value keeping the activity
+// which happened after t, see ActivityWithParams.
+func ActivityAfter(t time.Time) string {
+	return "AFTER " + strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// ActivityBefore returns an "update-date" filter value keeping the activity
+// which happened before t, see ActivityWithParams.
+func ActivityBefore(t time.Time) string {
+	return "BEFORE " + strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// activityUrl builds the activity url, every filter becoming its own
+// "streams" param whose value reads "<filter> <OPERATOR> <values>"
+func (j *Jira) activityUrl(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	query := make([]string, 0, len(names))
+	for _, name := range names {
+		value := params[name]
+		if activityPlainParams[name] {
+			query = append(query, url.QueryEscape(name)+"="+url.QueryEscape(value))
+			continue
+		}
+
+		hasOperator := false
+		for _, operator := range activityOperators {
+			if strings.HasPrefix(value, operator) {
+				hasOperator = true
+				break
+			}
+		}
+		if !hasOperator {
+			value = "IS " + value
+		}
+
+		query = append(query, "streams="+url.QueryEscape(name+" "+value))
+	}
+
+	activityUrl := j.url(j.ActivityPath, "")
+	if len(query) > 0 {
+		activityUrl += "?" + strings.Join(query, "&")
+	}
+
+	return activityUrl
+}
+
+/*
+Returns the activity of a project.
+
+	GET http://example.com:8080/jira/activity?streams=key+IS+FOO
+
+Parameters
+
+	projectKey string The key of the project
+
+Usage
+
+	feed, err := jira.ProjectActivity("FOO")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, entry := range feed.Entries {
+		fmt.Println(entry.Updated, entry.Title)
+	}
+*/
+func (j *Jira) ProjectActivity(projectKey string) (ActivityFeed, error) {
+	return j.ProjectActivityCtx(context.Background(), projectKey)
+}
+
+// ProjectActivityCtx is like ProjectActivity but aborts the request when ctx
+// is done.
+func (j *Jira) ProjectActivityCtx(ctx context.Context, projectKey string) (ActivityFeed, error) {
+	return j.ActivityWithParamsCtx(ctx, map[string]string{"key": projectKey})
+}
+
+/*
+Returns the activity matching a combination of stream filters.
+
+Each entry of params is a filter, such as "key", "user", "issue-key" or
+"update-date", whose value is an operator followed by its operands,
+"IS" being implied when the value has no operator. Multiple operands are
+separated by spaces. The "maxResults", "title", "providers", "os_authType"
+and "relativeLinks" entries are sent as plain parameters.
+
+	GET http://example.com:8080/jira/activity?streams=key+IS+FOO&streams=update-date+AFTER+1380000000000&maxResults=20
+
+Usage
+
+	feed, err := jira.ActivityWithParams(map[string]string{
+		"key":         "FOO BAR",
+		"update-date": gojira.ActivityAfter(time.Now().AddDate(0, 0, -7)),
+		"maxResults":  "20",
+	})
+*/
+func (j *Jira) ActivityWithParams(params map[string]string) (ActivityFeed, error) {
+	return j.ActivityWithParamsCtx(context.Background(), params)
+}
+
+// ActivityWithParamsCtx is like ActivityWithParams but aborts the request
+// when ctx is done.
+func (j *Jira) ActivityWithParamsCtx(ctx context.Context, params map[string]string) (ActivityFeed, error) {
+	return j.ActivityCtx(ctx, j.activityUrl(params))
+}
@@ -0,0 +1,76 @@
+package gojira
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// trimmed down from the activity stream of a jira server
+const activityFeedSample = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:activity="http://activitystrea.ms/spec/1.0/" xmlns:atlassian="http://streams.atlassian.com/syndication/general/1.0">
+  <id>https://jira.example.com/activity?maxResults=10&amp;streams=user+IS+jdoe</id>
+  <link href="https://jira.example.com/activity?maxResults=10&amp;streams=user+IS+jdoe" rel="self"/>
+  <title type="text">Activity Stream</title>
+  <atlassian:timezone-offset>+0200</atlassian:timezone-offset>
+  <updated>2013-04-12T09:31:55.360Z</updated>
+  <entry xmlns:atlassian="http://streams.atlassian.com/syndication/general/1.0">
+    <id>urn:uuid:6b1a1ab4-5ba1-3a3b-8bd4-9f0fe5a2a4c1</id>
+    <title type="html">&lt;a href="https://jira.example.com/secure/ViewProfile.jspa?name=jdoe"&gt;John Doe&lt;/a&gt; changed the status to Resolved on &lt;a href="https://jira.example.com/browse/FOO-12"&gt;FOO-12&lt;/a&gt;</title>
+    <author>
+      <name>John Doe</name>
+      <email>jdoe@example.com</email>
+      <uri>https://jira.example.com/secure/ViewProfile.jspa?name=jdoe</uri>
+      <usr:username xmlns:usr="http://streams.atlassian.com/syndication/username/1.0">jdoe</usr:username>
+    </author>
+    <published>2013-04-12T09:31:55.360Z</published>
+    <updated>2013-04-12T09:31:55.360Z</updated>
+    <category term="resolved"/>
+    <link href="https://jira.example.com/browse/FOO-12" rel="alternate"/>
+    <generator uri="https://jira.example.com"/>
+    <activity:verb>http://streams.atlassian.com/syndication/verbs/jira/transition</activity:verb>
+  </entry>
+  <entry xmlns:atlassian="http://streams.atlassian.com/syndication/general/1.0">
+    <id>urn:uuid:0d3f8d0e-3a1e-3c0c-9c7f-2f7d5cbd8e0a</id>
+    <title type="html">&lt;a href="https://jira.example.com/secure/ViewProfile.jspa?name=jdoe"&gt;John Doe&lt;/a&gt; created &lt;a href="https://jira.example.com/browse/FOO-12"&gt;FOO-12&lt;/a&gt;</title>
+    <author>
+      <name>John Doe</name>
+      <uri>https://jira.example.com/secure/ViewProfile.jspa?name=jdoe</uri>
+    </author>
+    <published>2013-04-11T16:02:07.012+02:00</published>
+    <updated>2013-04-11T16:02:07.012+02:00</updated>
+    <category term="created"/>
+    <link href="https://jira.example.com/browse/FOO-12" rel="alternate"/>
+  </entry>
+</feed>`
+
+func TestActivityUpdated(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml;charset=UTF-8")
+		w.Write([]byte(activityFeedSample))
+	})
+
+	feed, err := jira.UserActivity("jdoe")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2013, 4, 12, 9, 31, 55, 360000000, time.UTC)
+	if !feed.Updated.Equal(want) {
+		t.Errorf("feed Updated = %v, want %v", feed.Updated, want)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(feed.Entries))
+	}
+	if !feed.Entries[0].Updated.Equal(want) {
+		t.Errorf("entry Updated = %v, want %v", feed.Entries[0].Updated, want)
+	}
+	created := time.Date(2013, 4, 11, 14, 2, 7, 12000000, time.UTC)
+	if !feed.Entries[1].Updated.Equal(created) {
+		t.Errorf("entry Updated = %v, want %v", feed.Entries[1].Updated, created)
+	}
+	if feed.Entries[0].Category.Term != "resolved" {
+		t.Errorf("entry Category = %q, want resolved", feed.Entries[0].Category.Term)
+	}
+}
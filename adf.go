@@ -0,0 +1,136 @@
+package gojira
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// adfNode is a node of an Atlassian Document Format document, the rich
+// text format Jira Cloud uses for descriptions and comment bodies.
+type adfNode struct {
+	Type    string                 `json:"type"`
+	Text    string                 `json:"text"`
+	Attrs   map[string]interface{} `json:"attrs"`
+	Content []*adfNode             `json:"content"`
+}
+
+// block nodes are separated from what follows by a line break
+var adfBlockNodes = map[string]bool{
+	"paragraph":  true,
+	"heading":    true,
+	"blockquote": true,
+	"codeBlock":  true,
+	"listItem":   true,
+	"rule":       true,
+	"panel":      true,
+	"tableRow":   true,
+}
+
+/*
+Extracts the text of an Atlassian Document Format document, dropping its
+formatting. Paragraphs, headings and list items end up on their own
+lines, mentions and emojis are replaced by their text. It returns an
+empty string when raw isn't a valid document.
+
+Usage
+
+	fmt.Println(gojira.ADFToPlainText(issue.Fields.DescriptionADF))
+*/
+func ADFToPlainText(raw json.RawMessage) string {
+	root := adfNode{}
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return ""
+	}
+
+	var buffer strings.Builder
+	root.writeText(&buffer)
+	return strings.TrimRight(buffer.String(), "\n")
+}
+
+func (node *adfNode) writeText(buffer *strings.Builder) {
+	switch node.Type {
+	case "text":
+		buffer.WriteString(node.Text)
+	case "hardBreak":
+		buffer.WriteString("\n")
+	case "mention", "emoji", "date", "status":
+		if text, ok := node.Attrs["text"].(string); ok {
+			buffer.WriteString(text)
+		} else if name, ok := node.Attrs["shortName"].(string); ok {
+			buffer.WriteString(name)
+		}
+	case "inlineCard", "blockCard":
+		if url, ok := node.Attrs["url"].(string); ok {
+			buffer.WriteString(url)
+		}
+	case "tableCell", "tableHeader":
+		defer buffer.WriteString("\t")
+	}
+
+	for _, child := range node.Content {
+		child.writeText(buffer)
+	}
+
+	if adfBlockNodes[node.Type] {
+		text := buffer.String()
+		if text != "" && !strings.HasSuffix(text, "\n") {
+			buffer.WriteString("\n")
+		}
+	}
+}
+
+// textOrADF decodes a rich text field, a plain string on Jira Server and
+// an ADF document on Jira Cloud. Documents are returned as adf and
+// converted to text.
+func textOrADF(raw json.RawMessage) (text string, adf json.RawMessage, err error) {
+	raw = bytes.TrimSpace(raw)
+	switch {
+	case len(raw) == 0 || string(raw) == "null":
+		return "", nil, nil
+	case raw[0] == '{':
+		adf = append(json.RawMessage(nil), raw...)
+		return ADFToPlainText(adf), adf, nil
+	}
+
+	err = json.Unmarshal(raw, &text)
+	return
+}
+
+// richText returns text as expected by the api in rich text fields, as is
+// for version 2 and as an ADF document for version 3
+func (j *Jira) richText(text string) interface{} {
+	if j.apiVersion() < 3 {
+		return text
+	}
+
+	return textToADF(text)
+}
+
+// textToADF builds an ADF document holding text, a paragraph per block of
+// lines separated by blank lines
+func textToADF(text string) map[string]interface{} {
+	paragraphs := []interface{}{}
+	for _, block := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		if strings.TrimSpace(block) == "" {
+			continue
+		}
+
+		content := []interface{}{}
+		for i, line := range strings.Split(block, "\n") {
+			if i > 0 {
+				content = append(content, map[string]interface{}{"type": "hardBreak"})
+			}
+			if line != "" {
+				content = append(content, map[string]interface{}{"type": "text", "text": line})
+			}
+		}
+		paragraphs = append(paragraphs, map[string]interface{}{"type": "paragraph", "content": content})
+	}
+
+	return map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": paragraphs,
+	}
+}
@@ -0,0 +1,113 @@
+package gojira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestADFToPlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		adf      string
+		expected string
+	}{
+		{
+			"paragraphs",
+			`{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[{"type":"text","text":"Login is broken"}]},
+				{"type":"paragraph","content":[{"type":"text","text":"since"},{"type":"hardBreak"},{"type":"text","text":"the upgrade"}]}
+			]}`,
+			"Login is broken\nsince\nthe upgrade",
+		},
+		{
+			"marks",
+			`{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Deploy "},
+					{"type":"text","text":"now","marks":[{"type":"strong"}]},
+					{"type":"text","text":", see ","marks":[{"type":"em"}]},
+					{"type":"text","text":"the runbook","marks":[{"type":"link","attrs":{"href":"http://example.com"}}]}
+				]}
+			]}`,
+			"Deploy now, see the runbook",
+		},
+		{
+			"mentions",
+			`{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[
+					{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5","text":"@Jane Doe"}},
+					{"type":"text","text":" please review "},
+					{"type":"emoji","attrs":{"shortName":":eyes:"}}
+				]}
+			]}`,
+			"@Jane Doe please review :eyes:",
+		},
+		{
+			"lists",
+			`{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[{"type":"text","text":"Steps:"}]},
+				{"type":"orderedList","content":[
+					{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"log in"}]}]},
+					{"type":"listItem","content":[
+						{"type":"paragraph","content":[{"type":"text","text":"open"}]},
+						{"type":"bulletList","content":[
+							{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"settings"}]}]}
+						]}
+					]}
+				]}
+			]}`,
+			"Steps:\nlog in\nopen\nsettings",
+		},
+		{
+			"code blocks",
+			`{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[{"type":"text","text":"Run"}]},
+				{"type":"codeBlock","attrs":{"language":"shell"},"content":[{"type":"text","text":"go vet ./...\ngo test ./..."}]},
+				{"type":"paragraph","content":[{"type":"text","text":"then push"}]}
+			]}`,
+			"Run\ngo vet ./...\ngo test ./...\nthen push",
+		},
+		{"empty document", `{"type":"doc","version":1,"content":[]}`, ""},
+		{"invalid document", `"not a document"`, ""},
+	}
+
+	for _, test := range tests {
+		if text := ADFToPlainText(json.RawMessage(test.adf)); text != test.expected {
+			t.Errorf("%s: got %q, want %q", test.name, text, test.expected)
+		}
+	}
+}
+
+func TestTextOrADF(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected string
+		adf      bool
+	}{
+		{`"plain text"`, "plain text", false},
+		{`null`, "", false},
+		{``, "", false},
+		{`{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"rich text"}]}]}`, "rich text", true},
+	}
+
+	for _, test := range tests {
+		text, adf, err := textOrADF(json.RawMessage(test.raw))
+		if err != nil {
+			t.Errorf("%s: %v", test.raw, err)
+		}
+		if text != test.expected || (adf != nil) != test.adf {
+			t.Errorf("%s: got %q and adf %s", test.raw, text, adf)
+		}
+	}
+}
+
+func TestTextToADFRoundTrip(t *testing.T) {
+	raw, err := json.Marshal(textToADF("first line\nsecond line\n\nnext paragraph"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if text := ADFToPlainText(raw); text != "first line\nsecond line\nnext paragraph" {
+		t.Errorf("got %q", text)
+	}
+}
@@ -0,0 +1,404 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultAgilePath is the root of the agile api when Jira.AgilePath is empty
+	DefaultAgilePath = "/rest/agile/1.0"
+
+	board_url   = "/board"
+	sprint_url  = "/sprint"
+	backlog_url = "/backlog"
+
+	// jira rejects moves of more than 50 issues at once
+	moveIssuesChunkSize = 50
+
+	// the agile api expects ISO-8601 dates with a colon in the offset,
+	// unlike dateLayout of the core api
+	agileDateLayout = "2006-01-02T15:04:05.000Z07:00"
+)
+
+type Board struct {
+	Id   int    `json:"id"`
+	Self string `json:"self"`
+	Name string `json:"name"`
+	Type string `json:"type"` // "scrum" or "kanban"
+}
+
+type Sprint struct {
+	Id             int       `json:"id"`
+	Self           string    `json:"self"`
+	Name           string    `json:"name"`
+	Goal           string    `json:"goal"`
+	State          string    `json:"state"` // "future", "active" or "closed"
+	OriginBoardId  int       `json:"originBoardId"`
+	StartDate      string    `json:"startDate"`
+	StartDateAt    time.Time `json:"-"`
+	EndDate        string    `json:"endDate"`
+	EndDateAt      time.Time `json:"-"`
+	CompleteDate   string    `json:"completeDate"`
+	CompleteDateAt time.Time `json:"-"`
+}
+
+func (s *Sprint) parseTimes() (err error) {
+	times := []struct {
+		field string
+		value string
+		t     *time.Time
+	}{
+		{"sprint start", s.StartDate, &s.StartDateAt},
+		{"sprint end", s.EndDate, &s.EndDateAt},
+		{"sprint complete", s.CompleteDate, &s.CompleteDateAt},
+	}
+
+	for _, tt := range times {
+		t, parseErr := parseJiraTime(tt.value)
+		if parseErr != nil && err == nil {
+			err = &TimeParseError{Field: tt.field, Value: tt.value}
+		}
+		*tt.t = t
+	}
+
+	return
+}
+
+// agilePath returns the root of the agile api, to be given to url
+func (j *Jira) agilePath() string {
+	if j.AgilePath != "" {
+		return j.AgilePath
+	}
+
+	return DefaultAgilePath
+}
+
+/*
+Returns every board visible to the current user.
+
+	GET http://example.com:8080/jira/rest/agile/1.0/board
+
+Usage
+
+	boards, err := jira.Boards()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, board := range boards {
+		fmt.Println(board.Id, board.Name, board.Type)
+	}
+*/
+func (j *Jira) Boards() (boards []*Board, err error) {
+	return j.BoardsCtx(context.Background())
+}
+
+// BoardsCtx is like Boards but aborts the requests when ctx is done.
+func (j *Jira) BoardsCtx(ctx context.Context) (boards []*Board, err error) {
+	boards = []*Board{}
+	err = j.eachPage(ctx, j.url(j.agilePath(), board_url), nil, func(page *pagedValues) (int, error) {
+		var batch []*Board
+		if err := page.decode(&batch); err != nil {
+			return 0, err
+		}
+
+		boards = append(boards, batch...)
+		return len(batch), nil
+	})
+
+	return
+}
+
+/*
+Returns every sprint of a board, whatever its state.
+
+	GET http://example.com:8080/jira/rest/agile/1.0/board/{boardId}/sprint
+
+Parameters
+
+	boardId int The id of the board, only scrum boards have sprints
+
+Usage
+
+	sprints, err := jira.Sprints(board.Id)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, sprint := range sprints {
+		fmt.Println(sprint.Name, sprint.State, sprint.StartDateAt, sprint.EndDateAt)
+	}
+*/
+func (j *Jira) Sprints(boardId int) (sprints []*Sprint, err error) {
+	return j.SprintsCtx(context.Background(), boardId)
+}
+
+// SprintsCtx is like Sprints but aborts the requests when ctx is done.
+func (j *Jira) SprintsCtx(ctx context.Context, boardId int) (sprints []*Sprint, err error) {
+	url := j.url(j.agilePath(), board_url+"/%d"+sprint_url, boardId)
+
+	var timeErr error
+	sprints = []*Sprint{}
+	err = j.eachPage(ctx, url, nil, func(page *pagedValues) (int, error) {
+		var batch []*Sprint
+		if err := page.decode(&batch); err != nil {
+			return 0, err
+		}
+
+		for _, sprint := range batch {
+			if parseErr := sprint.parseTimes(); parseErr != nil && timeErr == nil {
+				timeErr = parseErr
+			}
+		}
+
+		sprints = append(sprints, batch...)
+		return len(batch), nil
+	})
+	if err == nil {
+		err = timeErr
+	}
+
+	return
+}
+
+/*
+Returns a page of the issues of a sprint.
+
+	GET http://example.com:8080/jira/rest/agile/1.0/sprint/{sprintId}/issue
+
+Parameters
+
+	sprintId   int The id of the sprint
+	startAt    int The index of the first issue to return (0-based)
+	maxResults int The maximum number of issues to return
+
+Usage
+
+	issues, err := jira.SprintIssues(sprint.Id, 0, 50)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, issue := range issues.Issues {
+		fmt.Println(issue.Key, issue.Fields.Summary)
+	}
+*/
+func (j *Jira) SprintIssues(sprintId int, startAt int, maxResults int) (issues IssueList, err error) {
+	return j.SprintIssuesCtx(context.Background(), sprintId, startAt, maxResults)
+}
+
+// SprintIssuesCtx is like SprintIssues but aborts the request when ctx is
+// done.
+func (j *Jira) SprintIssuesCtx(ctx context.Context, sprintId int, startAt int, maxResults int) (issues IssueList, err error) {
+	params := Params{
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+
+	url := j.url(j.agilePath(), sprint_url+"/%d/issue", sprintId) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(contents, &issues)
+	if err != nil {
+		return
+	}
+
+	err = issues.prepare()
+	return
+}
+
+// MoveIssuesError reports a move which partly failed, Moved holding the
+// keys which were moved and Failed the ones which were not. Err is the
+// error of the first failing request.
+type MoveIssuesError struct {
+	Moved  []string
+	Failed []string
+	Err    error
+}
+
+func (e *MoveIssuesError) Error() string {
+	return fmt.Sprintf("%d of %d issues could not be moved: %s", len(e.Failed), len(e.Moved)+len(e.Failed), e.Err)
+}
+
+func (e *MoveIssuesError) Unwrap() error {
+	return e.Err
+}
+
+/*
+Moves issues to a sprint. Keys are sent in chunks of 50, the limit of
+jira, and a *MoveIssuesError listing the keys which were not moved is
+returned when some of the chunks failed.
+
+	POST http://example.com:8080/jira/rest/agile/1.0/sprint/{sprintId}/issue
+
+Parameters
+
+	sprintId  int      The id of the sprint, which must be future or active
+	issueKeys []string The keys of the issues to move
+
+Usage
+
+	err := jira.MoveIssuesToSprint(sprint.Id, keys)
+	if moveErr, ok := err.(*gojira.MoveIssuesError); ok {
+		fmt.Println("not moved:", moveErr.Failed)
+	}
+*/
+func (j *Jira) MoveIssuesToSprint(sprintId int, issueKeys []string) error {
+	return j.MoveIssuesToSprintCtx(context.Background(), sprintId, issueKeys)
+}
+
+// MoveIssuesToSprintCtx is like MoveIssuesToSprint but aborts the requests
+// when ctx is done.
+func (j *Jira) MoveIssuesToSprintCtx(ctx context.Context, sprintId int, issueKeys []string) error {
+	return j.moveIssues(ctx, j.url(j.agilePath(), sprint_url+"/%d/issue", sprintId), issueKeys)
+}
+
+/*
+Moves issues to the backlog, removing them from any future or active sprint.
+Chunking and partial failures are handled as with MoveIssuesToSprint.
+
+	POST http://example.com:8080/jira/rest/agile/1.0/backlog/issue
+
+Usage
+
+	err := jira.MoveIssuesToBacklog([]string{"FOO-12", "FOO-13"})
+*/
+func (j *Jira) MoveIssuesToBacklog(issueKeys []string) error {
+	return j.MoveIssuesToBacklogCtx(context.Background(), issueKeys)
+}
+
+// MoveIssuesToBacklogCtx is like MoveIssuesToBacklog but aborts the requests
+// when ctx is done.
+func (j *Jira) MoveIssuesToBacklogCtx(ctx context.Context, issueKeys []string) error {
+	return j.moveIssues(ctx, j.url(j.agilePath(), backlog_url+"/issue"), issueKeys)
+}
+
+func (j *Jira) moveIssues(ctx context.Context, url string, issueKeys []string) error {
+	moveErr := &MoveIssuesError{}
+
+	for start := 0; start < len(issueKeys); start += moveIssuesChunkSize {
+		end := start + moveIssuesChunkSize
+		if end > len(issueKeys) {
+			end = len(issueKeys)
+		}
+		chunk := issueKeys[start:end]
+
+		_, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, map[string][]string{"issues": chunk})
+		if err != nil {
+			if moveErr.Err == nil {
+				moveErr.Err = err
+			}
+			moveErr.Failed = append(moveErr.Failed, chunk...)
+			continue
+		}
+
+		moveErr.Moved = append(moveErr.Moved, chunk...)
+	}
+
+	if moveErr.Err != nil {
+		return moveErr
+	}
+
+	return nil
+}
+
+/*
+Creates a future sprint on a board. Zero start and end times are left unset,
+they must then be set before the sprint can be started.
+
+	POST http://example.com:8080/jira/rest/agile/1.0/sprint
+
+Parameters
+
+	boardId int       The id of the board owning the sprint
+	name    string    The name of the sprint
+	start   time.Time The planned start of the sprint
+	end     time.Time The planned end of the sprint
+
+Usage
+
+	start := time.Now()
+	sprint, err := jira.CreateSprint(board.Id, "Sprint 42", start, start.AddDate(0, 0, 14))
+*/
+func (j *Jira) CreateSprint(boardId int, name string, start, end time.Time) (sprint *Sprint, err error) {
+	return j.CreateSprintCtx(context.Background(), boardId, name, start, end)
+}
+
+// CreateSprintCtx is like CreateSprint but aborts the request when ctx is
+// done.
+func (j *Jira) CreateSprintCtx(ctx context.Context, boardId int, name string, start, end time.Time) (sprint *Sprint, err error) {
+	payload := map[string]interface{}{
+		"name":          name,
+		"originBoardId": boardId,
+	}
+	if !start.IsZero() {
+		payload["startDate"] = start.Format(agileDateLayout)
+	}
+	if !end.IsZero() {
+		payload["endDate"] = end.Format(agileDateLayout)
+	}
+
+	url := j.url(j.agilePath(), sprint_url)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	if err != nil {
+		return
+	}
+
+	sprint = &Sprint{}
+	err = json.Unmarshal(contents, sprint)
+	if err != nil {
+		return
+	}
+
+	err = sprint.parseTimes()
+	return
+}
+
+/*
+Starts a future sprint, which must have its start and end dates set.
+
+	POST http://example.com:8080/jira/rest/agile/1.0/sprint/{sprintId}
+
+Usage
+
+	err := jira.StartSprint(sprint.Id)
+*/
+func (j *Jira) StartSprint(sprintId int) error {
+	return j.StartSprintCtx(context.Background(), sprintId)
+}
+
+// StartSprintCtx is like StartSprint but aborts the request when ctx is
+// done.
+func (j *Jira) StartSprintCtx(ctx context.Context, sprintId int) error {
+	return j.setSprintState(ctx, sprintId, "active")
+}
+
+/*
+Closes an active sprint.
+
+	POST http://example.com:8080/jira/rest/agile/1.0/sprint/{sprintId}
+
+Usage
+
+	err := jira.CloseSprint(sprint.Id)
+*/
+func (j *Jira) CloseSprint(sprintId int) error {
+	return j.CloseSprintCtx(context.Background(), sprintId)
+}
+
+// CloseSprintCtx is like CloseSprint but aborts the request when ctx is
+// done.
+func (j *Jira) CloseSprintCtx(ctx context.Context, sprintId int) error {
+	return j.setSprintState(ctx, sprintId, "closed")
+}
+
+// POST performs a partial update of the sprint, unlike PUT
+func (j *Jira) setSprintState(ctx context.Context, sprintId int, state string) (err error) {
+	url := j.url(j.agilePath(), sprint_url+"/%d", sprintId)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "POST", url, map[string]string{"state": state})
+	return
+}
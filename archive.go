@@ -0,0 +1,102 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrArchivingUnavailable is returned when jira answers 402 or 403 to an
+// archiving request, archiving being a Jira Cloud Premium feature which
+// also requires the Jira admin permission.
+var ErrArchivingUnavailable = errors.New("issue archiving is not available on this instance or to this user")
+
+type archiveResult struct {
+	NumberOfIssuesUpdated int                        `json:"numberOfIssuesUpdated"`
+	Errors                map[string]json.RawMessage `json:"errors"`
+}
+
+/*
+Archives an issue. Archived issues are hidden from searches and boards
+until restored.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/archive
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	err := jira.ArchiveIssue("FOO-12")
+	if errors.Is(err, gojira.ErrArchivingUnavailable) {
+		fmt.Println("archiving needs Jira Cloud Premium")
+	}
+*/
+func (j *Jira) ArchiveIssue(issueKey string) error {
+	return j.ArchiveIssueCtx(context.Background(), issueKey)
+}
+
+// ArchiveIssueCtx is like ArchiveIssue but aborts the request when ctx is
+// done.
+func (j *Jira) ArchiveIssueCtx(ctx context.Context, issueKey string) error {
+	return j.setArchived(ctx, "archive", issueKey)
+}
+
+/*
+Restores an archived issue.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/unarchive
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	err := jira.RestoreIssue("FOO-12")
+*/
+func (j *Jira) RestoreIssue(issueKey string) error {
+	return j.RestoreIssueCtx(context.Background(), issueKey)
+}
+
+// RestoreIssueCtx is like RestoreIssue but aborts the request when ctx is
+// done.
+func (j *Jira) RestoreIssueCtx(ctx context.Context, issueKey string) error {
+	return j.setArchived(ctx, "unarchive", issueKey)
+}
+
+// setArchived runs the "archive" or "unarchive" action on an issue
+func (j *Jira) setArchived(ctx context.Context, action, issueKey string) (err error) {
+	payload := map[string]interface{}{
+		"issueIdsOrKeys": []string{issueKey},
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s", action)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "PUT", url, payload)
+	if err != nil {
+		return statusError(err, ErrArchivingUnavailable, http.StatusPaymentRequired, http.StatusForbidden)
+	}
+	if len(contents) == 0 {
+		return
+	}
+
+	result := archiveResult{}
+	err = json.Unmarshal(contents, &result)
+	if err != nil {
+		return
+	}
+
+	// the issues which could not be updated are reported in a 200 response,
+	// grouped by reason, e.g. "issueIsSubtask" or "issuesInArchivedProjects"
+	if result.NumberOfIssuesUpdated == 0 {
+		for reason := range result.Errors {
+			return fmt.Errorf("%s was not updated: %s", issueKey, reason)
+		}
+		return fmt.Errorf("%s was not updated", issueKey)
+	}
+
+	return
+}
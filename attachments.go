@@ -0,0 +1,243 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	attachments_url = "/attachments"
+)
+
+type Attachment struct {
+	Self      string `json:"self"`
+	Id        string `json:"id"`
+	Filename  string `json:"filename"`
+	Author    *User  `json:"author"`
+	Created   string `json:"created"`
+	Size      int64  `json:"size"`
+	MimeType  string `json:"mimeType"`
+	Content   string `json:"content"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+/*
+Downloads the content of an attachment, as listed in the attachment field
+of an issue. The content is streamed rather than buffered so large files
+do not end up in memory, the caller being responsible for closing the
+returned reader. The download is not bounded by the timeout of the http
+client, use DownloadAttachmentCtx to give it a deadline. As with
+FetchImage, Auth is only sent when the content url has the scheme and host
+of BaseUrl.
+
+	GET http://example.com:8080/jira/secure/attachment/{id}/{filename}
+
+Usage
+
+	issue, err := jira.Issue("FOO-12", gojira.Params{"fields": "attachment"})
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, attachment := range issue.Fields.Attachments {
+		content, err := jira.DownloadAttachment(attachment)
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+		file, _ := os.Create(attachment.Filename)
+		io.Copy(file, content)
+		content.Close()
+		file.Close()
+	}
+*/
+func (j *Jira) DownloadAttachment(att *Attachment) (io.ReadCloser, error) {
+	return j.DownloadAttachmentCtx(context.Background(), att)
+}
+
+// DownloadAttachmentCtx is like DownloadAttachment but aborts the download,
+// including reading the returned content, when ctx is done.
+func (j *Jira) DownloadAttachmentCtx(ctx context.Context, att *Attachment) (io.ReadCloser, error) {
+	if att == nil || att.Content == "" {
+		return nil, errors.New("attachment has no content url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", att.Content, nil)
+	if err != nil {
+		return nil, errors.New("Error while building jira request")
+	}
+
+	j.applyOriginAuth(req)
+	resp, err := j.sendRequest(j.streamingClient(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// applyOriginAuth applies Auth to req only when it targets the scheme and
+// host of BaseUrl, content urls coming from jira responses. The http
+// client drops the credentials itself on redirects to another domain.
+func (j *Jira) applyOriginAuth(req *http.Request) {
+	base, err := url.Parse(j.BaseUrl)
+	if err == nil && sameOrigin(base, req.URL) {
+		j.Auth.apply(req)
+	}
+}
+
+/*
+Fetches an image served by jira, such as an issue type icon or a project
+or user avatar, which may not be readable anonymously. Relative urls are
+resolved against BaseUrl. Auth is only sent to urls having the scheme
+and host of BaseUrl, images hosted elsewhere, such as gravatar avatars,
+being fetched anonymously. Clients authenticated by their transport, as
+with OAuthConfig.Client, sign every request and should not fetch foreign
+urls. Jira redirecting to its login page instead of serving the image is
+reported as an error.
+
+Parameters
+
+	imageUrl string The url of the image, e.g. IssueType.IconUrl or an entry of AvatarUrls
+
+Usage
+
+	icon, contentType, err := jira.FetchImage(issue.Fields.IssueType.IconUrl)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(icon)
+*/
+func (j *Jira) FetchImage(imageUrl string) (image []byte, contentType string, err error) {
+	return j.FetchImageCtx(context.Background(), imageUrl)
+}
+
+// FetchImageCtx is like FetchImage but aborts the request when ctx is done.
+func (j *Jira) FetchImageCtx(ctx context.Context, imageUrl string) (image []byte, contentType string, err error) {
+	base, err := url.Parse(j.BaseUrl)
+	if err != nil {
+		return
+	}
+	ref, err := url.Parse(imageUrl)
+	if err != nil {
+		return
+	}
+
+	target := base.ResolveReference(ref)
+	req, err := http.NewRequestWithContext(ctx, "GET", target.String(), nil)
+	if err != nil {
+		return nil, "", errors.New("Error while building jira request")
+	}
+	req.Header.Set("Accept", "image/*")
+
+	j.applyOriginAuth(req)
+	resp, err := j.sendRequest(j.Client, req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	image, err = ioutil.ReadAll(j.limitBody(resp.Body))
+	if err != nil {
+		return nil, "", fmt.Errorf("reading image %s: %w", req.URL.Path, err)
+	}
+
+	contentType = resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(image)
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		// e.g. redirected to the login page
+		served := req.URL
+		if resp.Request != nil {
+			served = resp.Request.URL
+		}
+		return nil, "", fmt.Errorf("%s did not return an image but %s", redactedUrl(served), contentType)
+	}
+
+	return
+}
+
+/*
+Uploads a file as a new attachment of an issue and returns its metadata.
+The content is streamed to Jira as multipart/form-data along with the
+"X-Atlassian-Token: no-check" header, without which Jira answers 403.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/attachments
+
+Parameters
+
+	issueKey string    The issue id or key
+	filename string    The name of the attachment
+	r        io.Reader The attachment content
+
+Usage
+
+	file, _ := os.Open("report.pdf")
+	defer file.Close()
+	attachment, err := jira.AddAttachment("FOO-12", "report.pdf", file)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(attachment.Id, attachment.Size)
+*/
+func (j *Jira) AddAttachment(issueKey, filename string, r io.Reader) (attachment *Attachment, err error) {
+	return j.AddAttachmentCtx(context.Background(), issueKey, filename, r)
+}
+
+// AddAttachmentCtx is like AddAttachment but aborts the request when ctx is
+// done.
+func (j *Jira) AddAttachmentCtx(ctx context.Context, issueKey, filename string, r io.Reader) (attachment *Attachment, err error) {
+	if filename == "" {
+		return nil, errors.New("filename is required to add an attachment")
+	}
+
+	body, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		part, err := form.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+attachments_url, issueKey)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
+	if err != nil {
+		body.Close()
+		return nil, errors.New("Error while building jira request")
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	contents, err := j.execRequest(req)
+	// unblock the writing goroutine if the request did not consume it all
+	body.Close()
+	if err != nil {
+		return
+	}
+
+	attachments := []*Attachment{}
+	err = json.Unmarshal(contents, &attachments)
+	if err != nil {
+		return
+	}
+	if len(attachments) == 0 {
+		return nil, errors.New("jira did not return the uploaded attachment")
+	}
+
+	attachment = attachments[0]
+	return
+}
@@ -0,0 +1,104 @@
+package gojira
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// a 1x1 transparent gif
+var gifPixel = []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\x00\x00\x00\x00\x00\x00!\xf9\x04\x01\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+
+func TestFetchImageAuth(t *testing.T) {
+	imageHandler := func(authorized *bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*authorized = r.Header.Get("Authorization") != ""
+			w.Header().Set("Content-Type", "image/gif")
+			w.Write(gifPixel)
+		}
+	}
+
+	var jiraAuthorized, foreignAuthorized bool
+	jira := newTestJira(t, imageHandler(&jiraAuthorized))
+	foreign := httptest.NewServer(imageHandler(&foreignAuthorized))
+	defer foreign.Close()
+
+	if _, _, err := jira.FetchImage("/secure/viewavatar?avatarId=10318"); err != nil {
+		t.Fatal(err)
+	}
+	if !jiraAuthorized {
+		t.Error("expected credentials to be sent to jira")
+	}
+
+	image, contentType, err := jira.FetchImage(foreign.URL + "/avatar/205e460b479e2e5b48aec07710c08d50")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if foreignAuthorized {
+		t.Error("credentials were sent to another host")
+	}
+	if contentType != "image/gif" || len(image) != len(gifPixel) {
+		t.Errorf("got %d bytes of %s, want the gif", len(image), contentType)
+	}
+}
+
+func TestDownloadAttachmentWithoutClientTimeout(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("first half,"))
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(" second half"))
+	})
+	jira.Client.(*http.Client).Timeout = 100 * time.Millisecond
+
+	content, err := jira.DownloadAttachment(&Attachment{Content: jira.BaseUrl + "/secure/attachment/10001/notes.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		t.Fatalf("download cut by the client timeout: %v", err)
+	}
+	if string(data) != "first half, second half" {
+		t.Errorf("got %q", data)
+	}
+
+	// other requests remain bounded by the client timeout
+	if _, err := jira.Issue("FOO-12", nil); err == nil {
+		t.Error("expected the client timeout to apply to api calls")
+	}
+}
+
+func TestDownloadAttachmentAuth(t *testing.T) {
+	var jiraAuthorized, foreignAuthorized bool
+	handler := func(authorized *bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*authorized = r.Header.Get("Authorization") != ""
+			w.Write([]byte("content"))
+		}
+	}
+
+	jira := newTestJira(t, handler(&jiraAuthorized))
+	foreign := httptest.NewServer(handler(&foreignAuthorized))
+	defer foreign.Close()
+
+	for _, contentUrl := range []string{jira.BaseUrl + "/secure/attachment/10001/notes.txt", foreign.URL + "/notes.txt"} {
+		content, err := jira.DownloadAttachment(&Attachment{Content: contentUrl})
+		if err != nil {
+			t.Fatal(err)
+		}
+		content.Close()
+	}
+
+	if !jiraAuthorized {
+		t.Error("expected credentials to be sent to jira")
+	}
+	if foreignAuthorized {
+		t.Error("credentials were sent to another host")
+	}
+}
@@ -0,0 +1,157 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	changelog_url = "/changelog"
+)
+
+type Changelog struct {
+	StartAt    int                 `json:"startAt"`
+	MaxResults int                 `json:"maxResults"`
+	Total      int                 `json:"total"`
+	Histories  []*ChangelogHistory `json:"histories"`
+}
+
+// ChangelogHistory is a set of changes made at once by a user.
+type ChangelogHistory struct {
+	Id        string           `json:"id"`
+	Author    *User            `json:"author"`
+	Created   string           `json:"created"`
+	CreatedAt time.Time        `json:"-"`
+	Items     []*ChangelogItem `json:"items"`
+}
+
+// ChangelogItem is the change of a single field, From and To holding ids
+// (of users, statuses...) and FromString and ToString their display values.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FieldId    string `json:"fieldId"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+func (c *Changelog) parseTimes(issueKey string) (err error) {
+	for _, history := range c.Histories {
+		var parseErr error
+		history.CreatedAt, parseErr = parseJiraTime(history.Created)
+		if parseErr != nil && err == nil {
+			err = &TimeParseError{Issue: issueKey, Field: "changelog created", Value: history.Created}
+		}
+	}
+
+	return
+}
+
+/*
+Returns a page of the change history of an issue, oldest changes first.
+
+The paginated changelog endpoint is used when available (Jira Cloud and
+recent servers), older servers falling back to the changelog expanded on
+the issue, which is then paginated client side.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/changelog
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}?expand=changelog
+
+Parameters
+
+	issueKey   string The issue id or key
+	startAt    int    The index of the first history to return (0-based)
+	maxResults int    The maximum number of histories to return
+
+Usage
+
+	changelog, err := jira.Changelog("FOO-12", 0, 100)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, history := range changelog.Histories {
+		for _, item := range history.Items {
+			if item.Field == "status" {
+				fmt.Println(history.Author.DisplayName, history.CreatedAt, item.FromString, "->", item.ToString)
+			}
+		}
+	}
+*/
+func (j *Jira) Changelog(issueKey string, startAt int, maxResults int) (changelog *Changelog, err error) {
+	return j.ChangelogCtx(context.Background(), issueKey, startAt, maxResults)
+}
+
+// ChangelogCtx is like Changelog but aborts the request when ctx is done.
+func (j *Jira) ChangelogCtx(ctx context.Context, issueKey string, startAt int, maxResults int) (changelog *Changelog, err error) {
+	params := Params{
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+changelog_url, issueKey) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if errResponse, ok := err.(*ErrorResponse); ok && errResponse.StatusCode == http.StatusNotFound {
+		return j.expandedChangelog(ctx, issueKey, startAt, maxResults)
+	}
+	if err != nil {
+		return
+	}
+
+	page := pagedValues{}
+	err = json.Unmarshal(contents, &page)
+	if err != nil {
+		return
+	}
+
+	changelog = &Changelog{
+		StartAt:    page.StartAt,
+		MaxResults: page.MaxResults,
+		Total:      page.Total,
+	}
+	err = page.decode(&changelog.Histories)
+	if err != nil {
+		return
+	}
+
+	err = changelog.parseTimes(issueKey)
+	return
+}
+
+func (j *Jira) expandedChangelog(ctx context.Context, issueKey string, startAt int, maxResults int) (*Changelog, error) {
+	issue, err := j.IssueCtx(ctx, issueKey, Params{"expand": "changelog", "fields": "created"})
+	if err != nil {
+		var timeErr *TimeParseError
+		if !errors.As(err, &timeErr) {
+			return nil, err
+		}
+	}
+	if issue.Changelog == nil {
+		return &Changelog{StartAt: startAt, MaxResults: maxResults}, err
+	}
+
+	changelog := issue.Changelog
+	histories := changelog.Histories
+	if startAt < 0 {
+		startAt = 0
+	}
+	if startAt > len(histories) {
+		startAt = len(histories)
+	}
+	end := len(histories)
+	if maxResults > 0 && startAt+maxResults < end {
+		end = startAt + maxResults
+	}
+
+	return &Changelog{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(histories),
+		Histories:  histories[startAt:end],
+	}, err
+}
@@ -0,0 +1,164 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// cloneLinkType is the link type jira itself uses between a clone and
+// its source
+const cloneLinkType = "Cloners"
+
+// uncloneableFields may appear on the create screen but hold values
+// belonging to the source issue only
+var uncloneableFields = map[string]bool{
+	"attachment": true,
+	"comment":    true,
+	"issuelinks": true,
+	"subtasks":   true,
+	"worklog":    true,
+	"votes":      true,
+	"watches":    true,
+}
+
+/*
+Creates a copy of an issue and returns it with its Id, Key and Self
+populated. Only the fields found on the create screen of the source
+project and issue type are copied, which leaves out fields set by jira
+such as the status, resolution or creation date, as well as comments,
+attachments and links. overrides are then applied over the copied
+fields. Unexpected timestamps of the source do not prevent cloning it.
+When link is true and the instance defines the "Cloners" link type the
+clone is linked to its source, the clone being returned along with the
+error when that link fails.
+
+	GET  http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+	GET  http://example.com:8080/jira/rest/api/2/issue/createmeta
+	POST http://example.com:8080/jira/rest/api/2/issue
+	POST http://example.com:8080/jira/rest/api/2/issueLink
+
+Parameters
+
+	sourceKey string The id or key of the issue to copy
+	overrides map    Fields replacing the copied ones, may be nil
+	link      bool   Whether to link the clone to its source
+
+Usage
+
+	clone, err := jira.CloneIssue("FOO-12", map[string]interface{}{
+		"summary": "CLONE - Login is broken",
+	}, true)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(clone.Key)
+*/
+func (j *Jira) CloneIssue(sourceKey string, overrides map[string]interface{}, link bool) (issue *Issue, err error) {
+	return j.CloneIssueCtx(context.Background(), sourceKey, overrides, link)
+}
+
+// CloneIssueCtx is like CloneIssue but aborts the requests when ctx is done.
+func (j *Jira) CloneIssueCtx(ctx context.Context, sourceKey string, overrides map[string]interface{}, link bool) (issue *Issue, err error) {
+	source, err := j.IssueCtx(ctx, sourceKey, nil)
+	if err != nil {
+		var timeErr *TimeParseError
+		if !errors.As(err, &timeErr) {
+			return nil, err
+		}
+	}
+	if source.Fields == nil || source.Fields.Project == nil || source.Fields.IssueType == nil {
+		return nil, errors.New("issue " + sourceKey + " has no project or issue type to clone")
+	}
+
+	creatable, err := j.creatableFields(ctx, source.Fields.Project.Key, source.Fields.IssueType.Name)
+	if err != nil {
+		return
+	}
+
+	fields := make(map[string]interface{}, len(creatable)+len(overrides))
+	for id, raw := range source.Fields.Raw {
+		if !creatable[id] || uncloneableFields[id] || isEmptyJSON(raw) {
+			continue
+		}
+		fields[id] = raw
+	}
+	for id, value := range overrides {
+		fields[id] = value
+	}
+
+	url := j.url(j.ApiPath, issue_url)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, map[string]interface{}{"fields": fields})
+	if err != nil {
+		return
+	}
+
+	issue = &Issue{}
+	err = json.Unmarshal(contents, issue)
+	if err != nil {
+		return
+	}
+
+	if link {
+		err = j.linkClone(ctx, source.Key, issue.Key)
+	}
+	return
+}
+
+// creatableFields returns the ids of the fields of the create screen of
+// an issue type
+func (j *Jira) creatableFields(ctx context.Context, projectKey, issueTypeName string) (map[string]bool, error) {
+	meta, err := j.CreateMetaCtx(ctx, projectKey, issueTypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range meta.Projects {
+		for _, issueType := range project.IssueTypes {
+			if !strings.EqualFold(issueType.Name, issueTypeName) {
+				continue
+			}
+
+			creatable := make(map[string]bool, len(issueType.Fields))
+			for id := range issueType.Fields {
+				creatable[id] = true
+			}
+			return creatable, nil
+		}
+	}
+
+	return nil, fmt.Errorf("issue type %s of project %s cannot be created by the current user", issueTypeName, projectKey)
+}
+
+// linkClone links a clone to its source when the instance has a link
+// type for clones
+func (j *Jira) linkClone(ctx context.Context, sourceKey, cloneKey string) error {
+	linkTypes, err := j.LinkTypesCtx(ctx)
+	if err != nil {
+		return fmt.Errorf("linking clone %s to %s: %w", cloneKey, sourceKey, err)
+	}
+
+	for _, linkType := range linkTypes {
+		if strings.EqualFold(linkType.Name, cloneLinkType) {
+			// reads as "clone clones source"
+			if err = j.LinkIssuesCtx(ctx, sourceKey, cloneKey, linkType.Name); err != nil {
+				return fmt.Errorf("linking clone %s to %s: %w", cloneKey, sourceKey, err)
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// isEmptyJSON tells whether a raw field value holds nothing worth copying
+func isEmptyJSON(raw []byte) bool {
+	switch strings.TrimSpace(string(raw)) {
+	case "", "null", `""`, "[]", "{}":
+		return true
+	}
+
+	return false
+}
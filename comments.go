@@ -0,0 +1,280 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const (
+	comment_url = "/comment"
+)
+
+// ErrCommentNotFound is returned when editing or deleting a comment jira
+// answers 404 for, the comment not existing or not belonging to the issue.
+// ErrCommentForbidden is returned on 403, when the user lacks the
+// permission to edit or delete that comment.
+var (
+	ErrCommentNotFound  = errors.New("comment does not exist on this issue")
+	ErrCommentForbidden = errors.New("not allowed to change this comment")
+)
+
+func commentError(err error) error {
+	err = statusError(err, ErrCommentNotFound, http.StatusNotFound)
+	return statusError(err, ErrCommentForbidden, http.StatusForbidden)
+}
+
+/*
+Adds a new comment to an issue and returns it. A 404 *ErrorResponse is
+returned when the issue does not exist.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/comment
+
+Parameters
+
+	issueKey string The issue id or key
+	body     string The comment text
+
+Usage
+
+	comment, err := jira.AddComment("FOO-12", "Deployed to staging")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(comment.Id, comment.CreatedAt)
+*/
+func (j *Jira) AddComment(issueKey, body string) (*Comment, error) {
+	return j.AddCommentCtx(context.Background(), issueKey, body)
+}
+
+// AddCommentCtx is like AddComment but aborts the request when ctx is done.
+func (j *Jira) AddCommentCtx(ctx context.Context, issueKey, body string) (*Comment, error) {
+	return j.AddCommentWithVisibilityCtx(ctx, issueKey, body, nil)
+}
+
+/*
+Same as AddComment, restricting the comment to the given role or group
+so internal comments are not exposed to customers. A nil visibility
+makes the comment public.
+
+Usage
+
+	comment, err := jira.AddCommentWithVisibility("FOO-12", "Root cause is ...", &gojira.Visibility{
+		Type:  "role",
+		Value: "Developers",
+	})
+*/
+func (j *Jira) AddCommentWithVisibility(issueKey, body string, visibility *Visibility) (comment *Comment, err error) {
+	return j.AddCommentWithVisibilityCtx(context.Background(), issueKey, body, visibility)
+}
+
+// AddCommentWithVisibilityCtx is like AddCommentWithVisibility but aborts
+// the request when ctx is done.
+func (j *Jira) AddCommentWithVisibilityCtx(ctx context.Context, issueKey, body string, visibility *Visibility) (comment *Comment, err error) {
+	if issueKey == "" {
+		return nil, errors.New("issue key is required to add a comment")
+	}
+
+	payload := map[string]interface{}{"body": j.richText(body)}
+	if visibility != nil {
+		payload["visibility"] = visibility
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+comment_url, issueKey)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	if err != nil {
+		return
+	}
+
+	comment = &Comment{}
+	err = json.Unmarshal(contents, comment)
+	if err != nil {
+		return
+	}
+
+	err = comment.parseTimes(issueKey)
+	return
+}
+
+/*
+Replaces the body of a comment and returns the edited comment.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/comment/{id}
+
+Parameters
+
+	issueKey  string The issue id or key
+	commentId string The id of the comment
+	body      string The new comment text
+
+Usage
+
+	comment, err := jira.EditComment("FOO-12", "10000", "Deployed to production")
+	if errors.Is(err, gojira.ErrCommentForbidden) {
+		fmt.Println("cannot edit the comments of others")
+	}
+*/
+func (j *Jira) EditComment(issueKey, commentId, body string) (comment *Comment, err error) {
+	return j.EditCommentCtx(context.Background(), issueKey, commentId, body)
+}
+
+// EditCommentCtx is like EditComment but aborts the request when ctx is
+// done.
+func (j *Jira) EditCommentCtx(ctx context.Context, issueKey, commentId, body string) (comment *Comment, err error) {
+	if commentId == "" {
+		return nil, errors.New("comment id is required to edit a comment")
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+comment_url+"/%s", issueKey, commentId)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "PUT", url, map[string]interface{}{"body": j.richText(body)})
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	comment = &Comment{}
+	err = json.Unmarshal(contents, comment)
+	if err != nil {
+		return
+	}
+
+	err = comment.parseTimes(issueKey)
+	return
+}
+
+/*
+Deletes a comment.
+
+	DELETE http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/comment/{id}
+
+Parameters
+
+	issueKey  string The issue id or key
+	commentId string The id of the comment
+
+Usage
+
+	err := jira.DeleteComment("FOO-12", "10000")
+	if errors.Is(err, gojira.ErrCommentNotFound) {
+		fmt.Println("already deleted")
+	}
+*/
+func (j *Jira) DeleteComment(issueKey, commentId string) (err error) {
+	return j.DeleteCommentCtx(context.Background(), issueKey, commentId)
+}
+
+// DeleteCommentCtx is like DeleteComment but aborts the request when ctx is
+// done.
+func (j *Jira) DeleteCommentCtx(ctx context.Context, issueKey, commentId string) (err error) {
+	if commentId == "" {
+		return errors.New("comment id is required to delete a comment")
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+comment_url+"/%s", issueKey, commentId)
+	_, err = j.buildAndExecRequestCtx(ctx, "DELETE", url)
+	if err != nil {
+		return commentError(err)
+	}
+
+	return
+}
+
+// parse the comment timestamps, issueKey being used to report failures
+func (c *Comment) parseTimes(issueKey string) (err error) {
+	c.CreatedAt, err = parseJiraTime(c.Created)
+	if err != nil {
+		err = &TimeParseError{Issue: issueKey, Field: "comment created", Value: c.Created}
+	}
+
+	var updatedErr error
+	c.UpdatedAt, updatedErr = parseJiraTime(c.Updated)
+	if updatedErr != nil && err == nil {
+		err = &TimeParseError{Issue: issueKey, Field: "comment updated", Value: c.Updated}
+	}
+
+	return
+}
+
+func (ic *IssueComment) parseTimes(issueKey string) (err error) {
+	for i := range ic.Comments {
+		if commentErr := ic.Comments[i].parseTimes(issueKey); commentErr != nil && err == nil {
+			err = commentErr
+		}
+	}
+
+	return
+}
+
+/*
+Returns a page of the comments of an issue. Unlike the comments embedded in
+the issue, this endpoint is paginated and never truncates long discussions.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/comment
+
+Parameters
+
+	issueKey   string The issue id or key
+	startAt    int    The index of the first comment to return (0-based)
+	maxResults int    The maximum number of comments to return
+
+Usage
+
+	comments, pagination, err := jira.Comments("FOO-12", 0, 50)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, comment := range comments.Comments {
+		fmt.Println(comment.Author.DisplayName, comment.CreatedAt)
+	}
+	fmt.Println(pagination.PageCount)
+*/
+func (j *Jira) Comments(issueKey string, startAt int, maxResults int) (comments *IssueComment, pagination *Pagination, err error) {
+	return j.CommentsCtx(context.Background(), issueKey, startAt, maxResults)
+}
+
+// CommentsCtx is like Comments but aborts the request when ctx is done.
+func (j *Jira) CommentsCtx(ctx context.Context, issueKey string, startAt int, maxResults int) (comments *IssueComment, pagination *Pagination, err error) {
+	params := Params{
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+comment_url, issueKey) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	comments = &IssueComment{}
+	err = json.Unmarshal(contents, comments)
+	if err != nil {
+		return
+	}
+
+	pagination = &Pagination{
+		Total:      comments.Total,
+		StartAt:    comments.StartAt,
+		MaxResults: comments.MaxResults,
+	}
+	pagination.Compute()
+
+	err = comments.parseTimes(issueKey)
+	return
+}
+
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	// the body is either a string or an ADF document, see IssueFields
+	type comment Comment
+	fields := struct {
+		*comment
+		Body json.RawMessage `json:"body"`
+	}{comment: (*comment)(c)}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var err error
+	c.Body, c.BodyADF, err = textOrADF(fields.Body)
+	return err
+}
@@ -0,0 +1,153 @@
+package gojira
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+/*
+Streams every issue matching a JQL query, fetching the result pages in
+parallel. The first page is fetched to learn the total, the others are
+then spread over workers. Issues arrive in no particular order, with the
+fields returned by default by Search.
+
+Both channels are closed once every page was fetched. The error channel
+receives at most one error: the first page failing, which stops the
+others, or else the first unexpected timestamp. Cancelling ctx stops the
+search, ctx.Err() being reported. The issue channel must be drained for
+the search to complete.
+
+	GET http://example.com:8080/jira/rest/api/2/search
+
+Parameters
+
+	ctx      context.Context Stops the search when done
+	jql      string          The JQL query
+	pageSize int             The number of issues requested per page, SearchPageSize when zero
+	workers  int             The number of pages fetched at once, at least 1
+
+Usage
+
+	issues, errs := jira.SearchConcurrent(ctx, "project = FOO", 100, 4)
+	for issue := range issues {
+		fmt.Println(issue.Key)
+	}
+	if err := <-errs; err != nil {
+		fmt.Println(err.Error())
+	}
+*/
+func (j *Jira) SearchConcurrent(ctx context.Context, jql string, pageSize, workers int) (<-chan *Issue, <-chan error) {
+	if pageSize <= 0 {
+		pageSize = j.searchPageSize()
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+
+	issues := make(chan *Issue, pageSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(issues)
+
+		if err := j.searchConcurrent(ctx, jql, pageSize, workers, issues); err != nil {
+			errs <- err
+		}
+	}()
+
+	return issues, errs
+}
+
+func (j *Jira) searchConcurrent(ctx context.Context, jql string, pageSize, workers int, issues chan<- *Issue) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		timeErr  error
+	)
+	// fail records the error of a page, stopping the search on the
+	// first one which is not about timestamps
+	fail := func(err error) bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var parseErr *TimeParseError
+		if errors.As(err, &parseErr) {
+			if timeErr == nil {
+				timeErr = err
+			}
+			return false
+		}
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		return true
+	}
+
+	send := func(page IssueList) bool {
+		for _, issue := range page.Issues {
+			select {
+			case issues <- issue:
+			case <-ctx.Done():
+				return false
+			}
+		}
+		return true
+	}
+
+	first, err := j.searchPage(ctx, jql, 0, pageSize, nil, nil)
+	if err != nil && fail(err) {
+		return firstErr
+	}
+	if !send(first) {
+		return ctx.Err()
+	}
+
+	// jira may cap maxResults below the requested page size
+	if len(first.Issues) > 0 && len(first.Issues) < pageSize {
+		pageSize = len(first.Issues)
+	}
+
+	starts := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for startAt := range starts {
+				page, err := j.searchPage(ctx, jql, startAt, pageSize, nil, nil)
+				if err != nil && fail(err) {
+					return
+				}
+				if !send(page) {
+					return
+				}
+			}
+		}()
+	}
+
+feed:
+	for startAt := len(first.Issues); len(first.Issues) > 0 && startAt < first.Total; startAt += pageSize {
+		select {
+		case starts <- startAt:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(starts)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return timeErr
+}
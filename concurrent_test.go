@@ -0,0 +1,116 @@
+package gojira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// writeSearchPage answers a search with the issues FOO-<startAt+1> to
+// FOO-<startAt+maxResults>, total at most.
+func writeSearchPage(w http.ResponseWriter, r *http.Request, total int) {
+	startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+	maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+
+	issues := ""
+	for i := startAt; i < startAt+maxResults && i < total; i++ {
+		if issues != "" {
+			issues += ","
+		}
+		issues += fmt.Sprintf(`{"id":"%d","key":"FOO-%d"}`, i+1, i+1)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"startAt":%d,"maxResults":%d,"total":%d,"issues":[%s]}`, startAt, maxResults, total, issues)
+}
+
+func TestSearchConcurrent(t *testing.T) {
+	// the pages after the first one wait for each other, so that the
+	// search only completes when they are fetched in parallel
+	arrived := make(chan struct{}, 4)
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") != "0" {
+			arrived <- struct{}{}
+			for len(arrived) < 2 {
+				select {
+				case <-time.After(time.Millisecond):
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}
+		writeSearchPage(w, r, 6)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	issues, errs := jira.SearchConcurrent(ctx, "project = FOO", 2, 2)
+
+	keys := []string{}
+	for issue := range issues {
+		keys = append(keys, issue.Key)
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keys) != 6 || keys[0] != "FOO-1" || keys[1] != "FOO-2" {
+		t.Fatalf("expected the first page first, then the others, got %v", keys)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		if want := fmt.Sprintf("FOO-%d", i+1); key != want {
+			t.Errorf("expected every issue once, got %v", keys)
+			break
+		}
+	}
+}
+
+func TestSearchConcurrentFirstError(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "4" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeSearchPage(w, r, 10)
+	})
+
+	issues, errs := jira.SearchConcurrent(context.Background(), "project = FOO", 2, 2)
+	for range issues {
+	}
+
+	var errResponse *ErrorResponse
+	if err := <-errs; !errors.As(err, &errResponse) || errResponse.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected the 500 of the failing page, got %v", err)
+	}
+	if err, ok := <-errs; ok {
+		t.Errorf("expected a single error, got %v too", err)
+	}
+}
+
+func TestSearchConcurrentCanceled(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") != "0" {
+			<-r.Context().Done()
+			return
+		}
+		writeSearchPage(w, r, 10)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	issues, errs := jira.SearchConcurrent(ctx, "project = FOO", 2, 2)
+
+	<-issues
+	cancel()
+	for range issues {
+	}
+
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
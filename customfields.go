@@ -0,0 +1,157 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	field_url = "/field"
+)
+
+// FieldDef describes a system or custom field of the instance. Schema.Type
+// is e.g. "string", "number", "user" or "array".
+type FieldDef struct {
+	Id          string       `json:"id"`
+	Key         string       `json:"key"`
+	Name        string       `json:"name"`
+	Custom      bool         `json:"custom"`
+	Orderable   bool         `json:"orderable"`
+	Navigable   bool         `json:"navigable"`
+	Searchable  bool         `json:"searchable"`
+	ClauseNames []string     `json:"clauseNames"`
+	Schema      *FieldSchema `json:"schema"`
+}
+
+/*
+Returns the definitions of every system and custom field of the instance,
+mapping custom field ids to their names.
+
+	GET http://example.com:8080/jira/rest/api/2/field
+
+Usage
+
+	fields, err := jira.Fields()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, field := range fields {
+		if field.Custom {
+			jira.RegisterCustomField(field.Name, field.Id)
+		}
+	}
+*/
+func (j *Jira) Fields() (fields []*FieldDef, err error) {
+	return j.FieldsCtx(context.Background())
+}
+
+// FieldsCtx is like Fields but aborts the request when ctx is done.
+func (j *Jira) FieldsCtx(ctx context.Context) (fields []*FieldDef, err error) {
+	url := j.url(j.ApiPath, field_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	fields = []*FieldDef{}
+
+	err = json.Unmarshal(contents, &fields)
+	return
+}
+
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	// decode through a method-less alias to avoid recursing into
+	// UnmarshalJSON, the description being either a string or an ADF
+	// document
+	type issueFields IssueFields
+	fields := struct {
+		*issueFields
+		Description json.RawMessage `json:"description"`
+	}{issueFields: (*issueFields)(f)}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var err error
+	f.Description, f.DescriptionADF, err = textOrADF(fields.Description)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &f.Raw)
+}
+
+/*
+Decodes the raw value of a field, typically a custom field, into v.
+It reports false when the issue has no value for that field.
+
+Usage
+
+	var storyPoints float64
+	ok, err := issue.Fields.CustomField("customfield_10002", &storyPoints)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	if ok {
+		fmt.Println(storyPoints)
+	}
+*/
+func (f *IssueFields) CustomField(id string, v interface{}) (bool, error) {
+	raw, ok := f.Raw[id]
+	if !ok || string(raw) == "null" {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+/*
+Registers a logical name for a custom field id, so issues of this instance
+can be read with Jira.CustomField without hardcoding ids in the code.
+Registration is meant to happen once, before the client is used.
+
+Usage
+
+	jira.RegisterCustomField("storyPoints", "customfield_10002")
+*/
+func (j *Jira) RegisterCustomField(name, fieldID string) {
+	if j.customFields == nil {
+		j.customFields = make(map[string]string)
+	}
+
+	j.customFields[name] = fieldID
+}
+
+// CustomFieldID returns the field id registered for a logical name.
+func (j *Jira) CustomFieldID(name string) (string, bool) {
+	fieldID, ok := j.customFields[name]
+	return fieldID, ok
+}
+
+/*
+Decodes the value of a registered custom field of issue into v. It reports
+false when the issue has no value for that field, and an error when the
+name was never registered.
+
+Usage
+
+	var storyPoints float64
+	ok, err := jira.CustomField(issue, "storyPoints", &storyPoints)
+*/
+func (j *Jira) CustomField(issue *Issue, name string, v interface{}) (bool, error) {
+	fieldID, ok := j.CustomFieldID(name)
+	if !ok {
+		return false, errors.New("custom field " + name + " is not registered")
+	}
+
+	if issue == nil || issue.Fields == nil {
+		return false, nil
+	}
+
+	return issue.Fields.CustomField(fieldID, v)
+}
@@ -0,0 +1,111 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	dashboard_url = "/dashboard"
+	// page of the web ui editing a dashboard, not part of the api
+	dashboard_edit_url = "/secure/EditPortalPage!default.jspa"
+)
+
+type Dashboard struct {
+	Id               string             `json:"id"`
+	Self             string             `json:"self"`
+	Name             string             `json:"name"`
+	Description      string             `json:"description"`
+	Owner            *User              `json:"owner"`
+	IsFavourite      bool               `json:"isFavourite"`
+	Popularity       int                `json:"popularity"`
+	View             string             `json:"view"`
+	EditUrl          string             `json:"-"`
+	SharePermissions []*SharePermission `json:"sharePermissions"`
+	EditPermissions  []*SharePermission `json:"editPermissions"`
+}
+
+// SharePermission grants access to a dashboard or filter. Type is one of
+// "global", "loggedin", "project", "group" or "user", Project, Role, Group
+// and User being set accordingly.
+type SharePermission struct {
+	Id      int          `json:"id"`
+	Type    string       `json:"type"`
+	Project *JiraProject `json:"project"`
+	Role    *ProjectRole `json:"role"`
+	Group   *UserGroup   `json:"group"`
+	User    *User        `json:"user"`
+}
+
+type ProjectRole struct {
+	Self        string `json:"self"`
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type dashboardList struct {
+	StartAt    int          `json:"startAt"`
+	MaxResults int          `json:"maxResults"`
+	Total      int          `json:"total"`
+	Dashboards []*Dashboard `json:"dashboards"`
+}
+
+/*
+Returns a page of the dashboards visible to the current user.
+
+	GET http://example.com:8080/jira/rest/api/2/dashboard
+
+Parameters
+
+	startAt    int The index of the first dashboard to return (0-based)
+	maxResults int The maximum number of dashboards to return, at most 1000
+
+Usage
+
+	dashboards, pagination, err := jira.Dashboards(0, 50)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, dashboard := range dashboards {
+		fmt.Println(dashboard.Name, dashboard.View, dashboard.EditUrl)
+	}
+	fmt.Println(pagination.PageCount)
+*/
+func (j *Jira) Dashboards(startAt int, maxResults int) (dashboards []*Dashboard, pagination *Pagination, err error) {
+	return j.DashboardsCtx(context.Background(), startAt, maxResults)
+}
+
+// DashboardsCtx is like Dashboards but aborts the request when ctx is done.
+func (j *Jira) DashboardsCtx(ctx context.Context, startAt int, maxResults int) (dashboards []*Dashboard, pagination *Pagination, err error) {
+	params := Params{
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+
+	url := j.url(j.ApiPath, dashboard_url) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	list := dashboardList{}
+	err = json.Unmarshal(contents, &list)
+	if err != nil {
+		return
+	}
+
+	for _, dashboard := range list.Dashboards {
+		dashboard.EditUrl = j.BaseUrl + dashboard_edit_url + "?" + Params{"pageId": dashboard.Id}.Query()
+	}
+	dashboards = list.Dashboards
+
+	pagination = &Pagination{
+		Total:      list.Total,
+		StartAt:    list.StartAt,
+		MaxResults: list.MaxResults,
+	}
+	pagination.Compute()
+	return
+}
@@ -1,3 +1,5 @@
+//go:build ignore
+
 package main
 
 import (
@@ -45,7 +47,7 @@ func main() {
         config.Host,
         config.ApiPath,
         config.ActivityPath,
-        &gojira.Auth{config.Login, config.Password,},
+        &gojira.Auth{Login: config.Login, Password: config.Password},
     )
 
 	var method string
@@ -0,0 +1,318 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+Builds the "fields" object of create and update requests, giving each
+system field the shape jira expects. Setters can be chained.
+
+Usage
+
+	fields := gojira.NewFieldsBuilder().
+		SetProject("FOO").
+		SetIssueType("Bug").
+		SetSummary("Login is broken").
+		SetPriority("Major").
+		SetComponents("backend", "auth").
+		Set("customfield_10002", 3)
+	issue, err := jira.CreateIssueWithFields(fields)
+*/
+type FieldsBuilder struct {
+	fields map[string]interface{}
+	// rich text fields, converted according to the api version on use
+	texts map[string]string
+}
+
+func NewFieldsBuilder() *FieldsBuilder {
+	return &FieldsBuilder{
+		fields: make(map[string]interface{}),
+		texts:  make(map[string]string),
+	}
+}
+
+// Set sets a field, typically a custom field, to a value already in the
+// shape jira expects.
+func (b *FieldsBuilder) Set(id string, value interface{}) *FieldsBuilder {
+	delete(b.texts, id)
+	b.fields[id] = value
+	return b
+}
+
+func (b *FieldsBuilder) SetProject(key string) *FieldsBuilder {
+	return b.Set("project", map[string]string{"key": key})
+}
+
+func (b *FieldsBuilder) SetIssueType(name string) *FieldsBuilder {
+	return b.Set("issuetype", map[string]string{"name": name})
+}
+
+// SetParent makes the issue a sub-task of the parent issue.
+func (b *FieldsBuilder) SetParent(key string) *FieldsBuilder {
+	return b.Set("parent", map[string]string{"key": key})
+}
+
+func (b *FieldsBuilder) SetSummary(summary string) *FieldsBuilder {
+	return b.Set("summary", summary)
+}
+
+// SetDescription sets the description, sent as an ADF document with
+// version 3 of the api.
+func (b *FieldsBuilder) SetDescription(description string) *FieldsBuilder {
+	delete(b.fields, "description")
+	b.texts["description"] = description
+	return b
+}
+
+// SetAssignee assigns the issue to a Jira Cloud account, an empty id
+// leaving it unassigned.
+func (b *FieldsBuilder) SetAssignee(accountId string) *FieldsBuilder {
+	return b.Set("assignee", accountRef(accountId))
+}
+
+// SetReporter sets the reporter to a Jira Cloud account.
+func (b *FieldsBuilder) SetReporter(accountId string) *FieldsBuilder {
+	return b.Set("reporter", accountRef(accountId))
+}
+
+func (b *FieldsBuilder) SetPriority(name string) *FieldsBuilder {
+	return b.Set("priority", map[string]string{"name": name})
+}
+
+func (b *FieldsBuilder) SetComponents(names ...string) *FieldsBuilder {
+	return b.Set("components", namedRefs(names))
+}
+
+func (b *FieldsBuilder) SetFixVersions(names ...string) *FieldsBuilder {
+	return b.Set("fixVersions", namedRefs(names))
+}
+
+func (b *FieldsBuilder) SetAffectsVersions(names ...string) *FieldsBuilder {
+	return b.Set("versions", namedRefs(names))
+}
+
+// SetDueDate sets the due date to the day of date, in its location, a
+// zero date clearing it.
+func (b *FieldsBuilder) SetDueDate(date time.Time) *FieldsBuilder {
+	return b.Set("duedate", dueDate(date))
+}
+
+func (b *FieldsBuilder) SetLabels(labels ...string) *FieldsBuilder {
+	if labels == nil {
+		labels = []string{}
+	}
+	return b.Set("labels", labels)
+}
+
+// Build returns the fields as expected by version 2 of the api, to be
+// given to CreateIssue or UpdateIssue.
+func (b *FieldsBuilder) Build() map[string]interface{} {
+	fields := make(map[string]interface{}, len(b.fields)+len(b.texts))
+	for id, value := range b.fields {
+		fields[id] = value
+	}
+	for id, text := range b.texts {
+		fields[id] = text
+	}
+
+	return fields
+}
+
+// build returns the fields, rich text being in the shape expected by the
+// api version of j
+func (b *FieldsBuilder) build(j *Jira) map[string]interface{} {
+	fields := b.Build()
+	for id, text := range b.texts {
+		fields[id] = j.richText(text)
+	}
+
+	return fields
+}
+
+// dueDate formats a due date, nil clearing it
+func dueDate(date time.Time) interface{} {
+	if date.IsZero() {
+		return nil
+	}
+
+	return date.Format(dayLayout)
+}
+
+// accountRef references a user by account id, nil standing for nobody
+func accountRef(accountId string) interface{} {
+	if accountId == "" {
+		return nil
+	}
+
+	return map[string]string{"accountId": accountId}
+}
+
+// namedRefs references entities such as components or versions by name
+func namedRefs(names []string) []map[string]string {
+	refs := make([]map[string]string, len(names))
+	for i, name := range names {
+		refs[i] = map[string]string{"name": name}
+	}
+
+	return refs
+}
+
+/*
+Creates an issue from the fields of a FieldsBuilder, which must set at
+least the project, the issue type and the summary.
+
+	POST http://example.com:8080/jira/rest/api/2/issue
+
+Usage
+
+	issue, err := jira.CreateIssueWithFields(gojira.NewFieldsBuilder().
+		SetProject("FOO").
+		SetIssueType("Task").
+		SetSummary("Rotate the certificates").
+		SetAssignee(me.AccountId))
+*/
+func (j *Jira) CreateIssueWithFields(fields *FieldsBuilder) (issue *Issue, err error) {
+	return j.CreateIssueWithFieldsCtx(context.Background(), fields)
+}
+
+// CreateIssueWithFieldsCtx is like CreateIssueWithFields but aborts the
+// request when ctx is done.
+func (j *Jira) CreateIssueWithFieldsCtx(ctx context.Context, fields *FieldsBuilder) (issue *Issue, err error) {
+	if err = fields.validateCreate(); err != nil {
+		return
+	}
+
+	return j.createIssue(ctx, fields.build(j))
+}
+
+func (b *FieldsBuilder) validateCreate() error {
+	if b == nil {
+		return errors.New("fields are required to create an issue")
+	}
+	for _, id := range []string{"project", "issuetype", "summary"} {
+		if _, ok := b.fields[id]; !ok {
+			return errors.New(id + " is required to create an issue")
+		}
+	}
+
+	return nil
+}
+
+func (j *Jira) createIssue(ctx context.Context, fields map[string]interface{}) (issue *Issue, err error) {
+	url := j.url(j.ApiPath, issue_url)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, map[string]interface{}{"fields": fields})
+	if err != nil {
+		return
+	}
+
+	issue = &Issue{}
+	err = json.Unmarshal(contents, issue)
+	return
+}
+
+/*
+Edits an issue by overwriting the fields set on a FieldsBuilder.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Usage
+
+	err := jira.UpdateIssueWithFields("FOO-12", gojira.NewFieldsBuilder().
+		SetPriority("Critical").
+		SetLabels("regression"))
+*/
+func (j *Jira) UpdateIssueWithFields(key string, fields *FieldsBuilder) error {
+	return j.UpdateIssueWithFieldsCtx(context.Background(), key, fields)
+}
+
+// UpdateIssueWithFieldsCtx is like UpdateIssueWithFields but aborts the
+// request when ctx is done.
+func (j *Jira) UpdateIssueWithFieldsCtx(ctx context.Context, key string, fields *FieldsBuilder) error {
+	if fields == nil {
+		return errors.New("fields are required to update an issue")
+	}
+
+	return j.updateIssue(ctx, key, map[string]interface{}{"fields": fields.build(j)})
+}
+
+// prefix of the labels marking issues created by CreateIssueIdempotent
+const dedupeLabelPrefix = "dedupe-"
+
+/*
+Creates an issue unless one was already created with the same dedupe
+key, making retries of at-least-once pipelines safe. The key is stored
+as a "dedupe-<key>" label on the issue, which is searched before
+creating it. created tells whether the issue was created or found.
+Issues created moments before may not be searchable yet, jira indexing
+them asynchronously, so this protects against retries rather than
+against concurrent creations.
+
+	GET  http://example.com:8080/jira/rest/api/2/search
+	POST http://example.com:8080/jira/rest/api/2/issue
+
+Parameters
+
+	fields    *FieldsBuilder The fields of the issue, see CreateIssueWithFields
+	dedupeKey string         A key unique to the issue, without whitespace, e.g. an event id
+
+Usage
+
+	issue, created, err := jira.CreateIssueIdempotent(fields, "alert-"+alert.Id)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	if !created {
+		fmt.Println(issue.Key, "already exists")
+	}
+*/
+func (j *Jira) CreateIssueIdempotent(fields *FieldsBuilder, dedupeKey string) (issue *Issue, created bool, err error) {
+	return j.CreateIssueIdempotentCtx(context.Background(), fields, dedupeKey)
+}
+
+// CreateIssueIdempotentCtx is like CreateIssueIdempotent but aborts the
+// requests when ctx is done.
+func (j *Jira) CreateIssueIdempotentCtx(ctx context.Context, fields *FieldsBuilder, dedupeKey string) (issue *Issue, created bool, err error) {
+	if err = fields.validateCreate(); err != nil {
+		return
+	}
+	if dedupeKey == "" || strings.ContainsAny(dedupeKey, " \t\r\n") {
+		return nil, false, errors.New("dedupe key is required and must not contain whitespace")
+	}
+	label := dedupeLabelPrefix + dedupeKey
+
+	existing, err := j.SearchCtx(ctx, NewJQL().Eq("labels", label).String(), 0, 1, nil, nil)
+	if err != nil {
+		var timeErr *TimeParseError
+		if !errors.As(err, &timeErr) {
+			return nil, false, err
+		}
+	}
+	if len(existing.Issues) > 0 {
+		return existing.Issues[0], false, nil
+	}
+
+	issueFields := fields.build(j)
+	switch labels := issueFields["labels"].(type) {
+	case nil:
+		issueFields["labels"] = []string{label}
+	case []string:
+		issueFields["labels"] = append(append([]string{}, labels...), label)
+	case []interface{}:
+		issueFields["labels"] = append(append([]interface{}{}, labels...), label)
+	default:
+		return nil, false, fmt.Errorf("labels must be a list, not %T", labels)
+	}
+
+	issue, err = j.createIssue(ctx, issueFields)
+	if err != nil {
+		return
+	}
+
+	return issue, true, nil
+}
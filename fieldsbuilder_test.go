@@ -0,0 +1,86 @@
+package gojira
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func newIdempotentFields() *FieldsBuilder {
+	return NewFieldsBuilder().
+		SetProject("FOO").
+		SetIssueType("Bug").
+		SetSummary("Disk full").
+		SetLabels("regression")
+}
+
+func TestCreateIssueIdempotentExisting(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/api/2/search" {
+			t.Errorf("unexpected request %s %s, the issue exists", r.Method, r.URL.Path)
+			return
+		}
+		if jql := r.URL.Query().Get("jql"); jql != `labels = "dedupe-alert-1"` {
+			t.Errorf("unexpected jql %s", jql)
+		}
+		w.Write([]byte(`{"startAt":0,"maxResults":1,"total":1,"issues":[{"id":"10007","key":"FOO-7"}]}`))
+	})
+
+	issue, created, err := jira.CreateIssueIdempotent(newIdempotentFields(), "alert-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created || issue.Key != "FOO-7" {
+		t.Errorf("expected FOO-7 to be found, got %s created %v", issue.Key, created)
+	}
+}
+
+func TestCreateIssueIdempotentCreated(t *testing.T) {
+	var labels []string
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/search":
+			w.Write([]byte(`{"startAt":0,"maxResults":1,"total":0,"issues":[]}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/issue":
+			var payload struct {
+				Fields struct {
+					Labels []string `json:"labels"`
+				} `json:"fields"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Error(err)
+			}
+			labels = payload.Fields.Labels
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"10008","key":"FOO-8"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	fields := newIdempotentFields()
+	issue, created, err := jira.CreateIssueIdempotent(fields, "alert-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created || issue.Key != "FOO-8" {
+		t.Errorf("expected FOO-8 to be created, got %s created %v", issue.Key, created)
+	}
+	if !reflect.DeepEqual(labels, []string{"regression", "dedupe-alert-1"}) {
+		t.Errorf("expected the dedupe label along with the others, got %v", labels)
+	}
+	if built := fields.Build()["labels"]; !reflect.DeepEqual(built, []string{"regression"}) {
+		t.Errorf("expected the builder labels to be left untouched, got %v", built)
+	}
+}
+
+func TestCreateIssueIdempotentInvalidKey(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	if _, _, err := jira.CreateIssueIdempotent(newIdempotentFields(), "alert 1"); err == nil {
+		t.Error("expected a dedupe key with whitespace to be rejected")
+	}
+}
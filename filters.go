@@ -0,0 +1,199 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	filter_url = "/filter"
+)
+
+// ErrFilterInaccessible is returned when a filter is private to another
+// user, or doesn't exist, which jira doesn't tell apart.
+var ErrFilterInaccessible = errors.New("filter does not exist or is not shared with the current user")
+
+// Filter is a saved search.
+type Filter struct {
+	Self        string `json:"self"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Owner       *User  `json:"owner"`
+	Jql         string `json:"jql"`
+	ViewUrl     string `json:"viewUrl"`
+	SearchUrl   string `json:"searchUrl"`
+	Favourite   bool   `json:"favourite"`
+}
+
+// jira server answers 400 for filters the user can't see
+var filterInaccessibleCodes = []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound}
+
+/*
+Returns a saved filter.
+
+	GET http://example.com:8080/jira/rest/api/2/filter/{id}
+
+Parameters
+
+	id string The id of the filter
+
+Usage
+
+	filter, err := jira.Filter("10010")
+	if errors.Is(err, gojira.ErrFilterInaccessible) {
+		fmt.Println("filter is private")
+	}
+	fmt.Println(filter.Name, filter.Owner.DisplayName, filter.Jql)
+*/
+func (j *Jira) Filter(id string) (filter *Filter, err error) {
+	return j.FilterCtx(context.Background(), id)
+}
+
+// FilterCtx is like Filter but aborts the request when ctx is done.
+func (j *Jira) FilterCtx(ctx context.Context, id string) (filter *Filter, err error) {
+	url := j.url(j.ApiPath, filter_url+"/%s", id)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return nil, statusError(err, ErrFilterInaccessible, filterInaccessibleCodes...)
+	}
+
+	filter = &Filter{}
+
+	err = json.Unmarshal(contents, filter)
+	return
+}
+
+/*
+Runs the query of a saved filter and returns a page of matching issues,
+with the fields returned by default by Search.
+
+	GET http://example.com:8080/jira/rest/api/2/filter/{id}
+	GET http://example.com:8080/jira/rest/api/2/search?jql=JQL
+
+Parameters
+
+	id         string The id of the filter
+	startAt    int    The index of the first issue to return (0-based)
+	maxResults int    The maximum number of issues to return
+
+Usage
+
+	issues, err := jira.RunFilter("10010", 0, 50)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, issue := range issues.Issues {
+		fmt.Println(issue.Key, issue.Fields.Summary)
+	}
+*/
+func (j *Jira) RunFilter(id string, startAt, maxResults int) (issues IssueList, err error) {
+	return j.RunFilterCtx(context.Background(), id, startAt, maxResults)
+}
+
+// RunFilterCtx is like RunFilter but aborts the requests when ctx is done.
+func (j *Jira) RunFilterCtx(ctx context.Context, id string, startAt, maxResults int) (issues IssueList, err error) {
+	filter, err := j.FilterCtx(ctx, id)
+	if err != nil {
+		return
+	}
+
+	return j.SearchCtx(ctx, filter.Jql, startAt, maxResults, nil, nil)
+}
+
+/*
+Saves a filter owned by the current user. When jira rejects the query the
+returned error carries its validation message, the *ErrorResponse
+remaining reachable with errors.As.
+
+	POST http://example.com:8080/jira/rest/api/2/filter
+
+Parameters
+
+	name        string The name of the filter, unique per owner
+	jql         string The query of the filter
+	description string The description of the filter, may be empty
+	favourite   bool   Whether the filter is added to the favourites of the current user
+
+Usage
+
+	filter, err := jira.CreateFilter("My bugs", "assignee = currentUser() AND type = Bug", "", true)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(filter.Id, filter.ViewUrl)
+*/
+func (j *Jira) CreateFilter(name, jql, description string, favourite bool) (filter *Filter, err error) {
+	return j.CreateFilterCtx(context.Background(), name, jql, description, favourite)
+}
+
+// CreateFilterCtx is like CreateFilter but aborts the request when ctx is
+// done.
+func (j *Jira) CreateFilterCtx(ctx context.Context, name, jql, description string, favourite bool) (filter *Filter, err error) {
+	if name == "" {
+		return nil, errors.New("name is required to create a filter")
+	}
+
+	payload := map[string]interface{}{
+		"name":      name,
+		"jql":       jql,
+		"favourite": favourite,
+	}
+	if description != "" {
+		payload["description"] = description
+	}
+
+	url := j.url(j.ApiPath, filter_url)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	if err != nil {
+		var errResponse *ErrorResponse
+		if errors.As(err, &errResponse) && errResponse.Errors["jql"] != "" {
+			err = fmt.Errorf("invalid filter query %q: %w", jql, errResponse)
+		}
+		return
+	}
+
+	filter = &Filter{}
+
+	err = json.Unmarshal(contents, filter)
+	return
+}
+
+/*
+Adds a filter to, or removes it from, the favourites of the current user.
+
+	PUT    http://example.com:8080/jira/rest/api/2/filter/{id}/favourite
+	DELETE http://example.com:8080/jira/rest/api/2/filter/{id}/favourite
+
+Parameters
+
+	id        string The id of the filter
+	favourite bool   true to add the filter to the favourites, false to remove it
+
+Usage
+
+	err := jira.SetFilterFavourite("10010", false)
+*/
+func (j *Jira) SetFilterFavourite(id string, favourite bool) (err error) {
+	return j.SetFilterFavouriteCtx(context.Background(), id, favourite)
+}
+
+// SetFilterFavouriteCtx is like SetFilterFavourite but aborts the request
+// when ctx is done.
+func (j *Jira) SetFilterFavouriteCtx(ctx context.Context, id string, favourite bool) (err error) {
+	method := "PUT"
+	if !favourite {
+		method = "DELETE"
+	}
+
+	url := j.url(j.ApiPath, filter_url+"/%s/favourite", id)
+	_, err = j.buildAndExecRequestCtx(ctx, method, url)
+	if err != nil {
+		return statusError(err, ErrFilterInaccessible, filterInaccessibleCodes...)
+	}
+
+	return
+}
@@ -0,0 +1,3 @@
+module github.com/plouc/go-jira-client
+
+go 1.20
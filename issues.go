@@ -0,0 +1,544 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	issue_url      = "/issue"
+	issue_type_url = "/issuetype"
+	assignee_url   = "/assignee"
+)
+
+/*
+Creates an issue or a sub-task and returns it with its Id, Key and Self populated.
+
+	POST http://example.com:8080/jira/rest/api/2/issue
+
+Parameters
+
+	project     string The key of the project the issue belongs to
+	issueType   string The name of the issue type, e.g. "Bug"
+	summary     string The issue summary
+	description string The issue description, omitted when empty
+	fields      map    Extra fields merged into the "fields" object
+	                   (labels, priority, custom fields...)
+
+Usage
+
+	issue, err := jira.CreateIssue("FOO", "Bug", "Login is broken", "", map[string]interface{}{
+		"labels":   []string{"regression"},
+		"priority": map[string]string{"name": "Major"},
+	})
+	if err != nil {
+		if errResponse, ok := err.(*gojira.ErrorResponse); ok {
+			fmt.Printf("%+v\n", errResponse.Errors)
+		}
+	}
+	fmt.Println(issue.Key)
+*/
+func (j *Jira) CreateIssue(project, issueType, summary, description string, fields map[string]interface{}) (issue *Issue, err error) {
+	return j.CreateIssueCtx(context.Background(), project, issueType, summary, description, fields)
+}
+
+// CreateIssueCtx is like CreateIssue but aborts the request when ctx is done.
+func (j *Jira) CreateIssueCtx(ctx context.Context, project, issueType, summary, description string, fields map[string]interface{}) (issue *Issue, err error) {
+	switch {
+	case project == "":
+		return nil, errors.New("project is required to create an issue")
+	case issueType == "":
+		return nil, errors.New("issue type is required to create an issue")
+	case summary == "":
+		return nil, errors.New("summary is required to create an issue")
+	}
+
+	issueFields := make(map[string]interface{}, len(fields)+4)
+	for name, value := range fields {
+		issueFields[name] = value
+	}
+	issueFields["project"] = map[string]string{"key": project}
+	issueFields["issuetype"] = map[string]string{"name": issueType}
+	issueFields["summary"] = summary
+	if description != "" {
+		issueFields["description"] = j.richText(description)
+	}
+
+	return j.createIssue(ctx, issueFields)
+}
+
+/*
+Edits an issue by overwriting the given fields. Jira answers with 204 No Content on success.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Parameters
+
+	key    string The issue id or key
+	fields map    The fields to set, as expected by the "fields" object
+
+Usage
+
+	err := jira.UpdateIssue("FOO-12", map[string]interface{}{
+		"summary": "A better summary",
+	})
+*/
+func (j *Jira) UpdateIssue(key string, fields map[string]interface{}) error {
+	return j.UpdateIssueCtx(context.Background(), key, fields)
+}
+
+// UpdateIssueCtx is like UpdateIssue but aborts the request when ctx is done.
+func (j *Jira) UpdateIssueCtx(ctx context.Context, key string, fields map[string]interface{}) error {
+	return j.updateIssue(ctx, key, map[string]interface{}{"fields": fields})
+}
+
+/*
+Edits an issue using update operations rather than overwriting fields,
+which allows adding or removing single elements of array fields.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Parameters
+
+	key    string The issue id or key
+	update map    Operations keyed by field name, each operation being a
+	              single entry map of verb (set, add, remove, edit) to value
+
+Usage
+
+	err := jira.UpdateIssueOperations("FOO-12", map[string][]map[string]interface{}{
+		"labels": {
+			{"add": "triaged"},
+			{"remove": "new"},
+		},
+	})
+*/
+func (j *Jira) UpdateIssueOperations(key string, update map[string][]map[string]interface{}) error {
+	return j.UpdateIssueOperationsCtx(context.Background(), key, update)
+}
+
+// UpdateIssueOperationsCtx is like UpdateIssueOperations but aborts the request when ctx is done.
+func (j *Jira) UpdateIssueOperationsCtx(ctx context.Context, key string, update map[string][]map[string]interface{}) error {
+	return j.updateIssue(ctx, key, map[string]interface{}{"update": update})
+}
+
+func (j *Jira) updateIssue(ctx context.Context, key string, payload map[string]interface{}) (err error) {
+	if key == "" {
+		return errors.New("issue key is required to update an issue")
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s", key)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "PUT", url, payload)
+	return
+}
+
+/*
+Deletes an issue. Jira answers with 204 No Content on success, 403 when the
+user is not allowed to delete the issue and 400 when the issue has sub-tasks
+and deleteSubtasks is false, all of them reported as *ErrorResponse.
+
+	DELETE http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}?deleteSubtasks=false
+
+Parameters
+
+	key            string The issue id or key
+	deleteSubtasks bool   Whether the sub-tasks of the issue should be deleted too
+
+Usage
+
+	err := jira.DeleteIssue("FOO-12", true)
+*/
+func (j *Jira) DeleteIssue(key string, deleteSubtasks bool) (err error) {
+	return j.DeleteIssueCtx(context.Background(), key, deleteSubtasks)
+}
+
+// DeleteIssueCtx is like DeleteIssue but aborts the request when ctx is done.
+func (j *Jira) DeleteIssueCtx(ctx context.Context, key string, deleteSubtasks bool) (err error) {
+	if key == "" {
+		return errors.New("issue key is required to delete an issue")
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s", key) + "?deleteSubtasks=" + strconv.FormatBool(deleteSubtasks)
+	_, err = j.buildAndExecRequestCtx(ctx, "DELETE", url)
+	return
+}
+
+/*
+Assigns an issue to a user.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/assignee
+
+Parameters
+
+	issueKey  string The issue id or key
+	accountId string The account id of the assignee, "-1" to assign the issue
+	                 to the project default assignee, empty to unassign it
+
+Usage
+
+	err := jira.AssignIssue("FOO-12", "5b10ac8d82e05b22cc7d4ef5")
+	// back to nobody
+	err = jira.AssignIssue("FOO-12", "")
+*/
+func (j *Jira) AssignIssue(issueKey, accountId string) (err error) {
+	return j.AssignIssueCtx(context.Background(), issueKey, accountId)
+}
+
+// AssignIssueCtx is like AssignIssue but aborts the request when ctx is
+// done.
+func (j *Jira) AssignIssueCtx(ctx context.Context, issueKey, accountId string) (err error) {
+	payload := map[string]interface{}{"accountId": nil}
+	if accountId != "" {
+		payload["accountId"] = accountId
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+assignee_url, issueKey)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "PUT", url, payload)
+	return
+}
+
+/*
+Creates a sub-task of an issue in the project of its parent and returns it
+with its Key populated.
+
+The sub-task issue type can be chosen with an "issuetype" entry in fields,
+e.g. map[string]string{"name": "Technical task"}, which must be a sub-task
+type. The first sub-task type of the instance is used otherwise.
+
+	POST http://example.com:8080/jira/rest/api/2/issue
+
+Parameters
+
+	parentKey   string The key of the parent issue
+	summary     string The sub-task summary
+	description string The sub-task description, omitted when empty
+	fields      map    Extra fields merged into the "fields" object
+
+Usage
+
+	subtask, err := jira.CreateSubtask("FOO-12", "Write the migration", "", nil)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(subtask.Key)
+*/
+func (j *Jira) CreateSubtask(parentKey, summary, description string, fields map[string]interface{}) (*Issue, error) {
+	return j.CreateSubtaskCtx(context.Background(), parentKey, summary, description, fields)
+}
+
+// CreateSubtaskCtx is like CreateSubtask but aborts the requests when ctx
+// is done.
+func (j *Jira) CreateSubtaskCtx(ctx context.Context, parentKey, summary, description string, fields map[string]interface{}) (*Issue, error) {
+	if parentKey == "" {
+		return nil, errors.New("parent key is required to create a sub-task")
+	}
+
+	parent, err := j.IssueCtx(ctx, parentKey, Params{"fields": "project"})
+	if err != nil {
+		var timeErr *TimeParseError
+		if !errors.As(err, &timeErr) {
+			return nil, err
+		}
+	}
+	if parent.Fields == nil || parent.Fields.Project == nil {
+		return nil, errors.New("could not find the project of issue " + parentKey)
+	}
+
+	issueType, err := j.subtaskType(ctx, issueTypeName(fields["issuetype"]))
+	if err != nil {
+		return nil, err
+	}
+
+	subtaskFields := make(map[string]interface{}, len(fields)+1)
+	for name, value := range fields {
+		subtaskFields[name] = value
+	}
+	subtaskFields["parent"] = map[string]string{"key": parentKey}
+
+	return j.CreateIssueCtx(ctx, parent.Fields.Project.Key, issueType.Name, summary, description, subtaskFields)
+}
+
+// name of an issue type given as {"name": "..."}, or as a bare string
+func issueTypeName(value interface{}) string {
+	switch issueType := value.(type) {
+	case string:
+		return issueType
+	case map[string]string:
+		return issueType["name"]
+	case map[string]interface{}:
+		name, _ := issueType["name"].(string)
+		return name
+	}
+
+	return ""
+}
+
+// subtaskType returns the sub-task issue type with the given name, or the
+// first sub-task type of the instance when name is empty
+func (j *Jira) subtaskType(ctx context.Context, name string) (*IssueType, error) {
+	issueTypes, err := j.IssueTypesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issueType := range issueTypes {
+		switch {
+		case name == "" && issueType.Subtask:
+			return issueType, nil
+		case name == "" || !strings.EqualFold(issueType.Name, name):
+			continue
+		case !issueType.Subtask:
+			return nil, errors.New("issue type " + issueType.Name + " is not a sub-task type")
+		}
+
+		return issueType, nil
+	}
+
+	if name != "" {
+		return nil, errors.New("unknown issue type " + name)
+	}
+
+	return nil, errors.New("no sub-task issue type is defined")
+}
+
+/*
+Returns every issue type of the instance, sub-task types included.
+
+	GET http://example.com:8080/jira/rest/api/2/issuetype
+
+Usage
+
+	issueTypes, err := jira.IssueTypes()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, issueType := range issueTypes {
+		fmt.Println(issueType.Name, issueType.Subtask, issueType.IconUrl)
+	}
+*/
+func (j *Jira) IssueTypes() (issueTypes []*IssueType, err error) {
+	return j.IssueTypesCtx(context.Background())
+}
+
+// IssueTypesCtx is like IssueTypes but aborts the request when ctx is done.
+func (j *Jira) IssueTypesCtx(ctx context.Context) (issueTypes []*IssueType, err error) {
+	url := j.url(j.ApiPath, issue_type_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	issueTypes = []*IssueType{}
+	err = json.Unmarshal(contents, &issueTypes)
+	return
+}
+
+/*
+Returns the issue types valid in a project, sub-task types included,
+which can be told apart with Subtask.
+
+	GET http://example.com:8080/jira/rest/api/2/project/{projectIdOrKey}
+
+Parameters
+
+	projectKey string The project id or key
+
+Usage
+
+	issueTypes, err := jira.ProjectIssueTypes("FOO")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, issueType := range issueTypes {
+		if !issueType.Subtask {
+			fmt.Println(issueType.Name)
+		}
+	}
+*/
+func (j *Jira) ProjectIssueTypes(projectKey string) (issueTypes []*IssueType, err error) {
+	return j.ProjectIssueTypesCtx(context.Background(), projectKey)
+}
+
+// ProjectIssueTypesCtx is like ProjectIssueTypes but aborts the request when
+// ctx is done.
+func (j *Jira) ProjectIssueTypesCtx(ctx context.Context, projectKey string) (issueTypes []*IssueType, err error) {
+	project, err := j.ProjectCtx(ctx, projectKey)
+	if err != nil {
+		return
+	}
+
+	return project.IssueTypes, nil
+}
+
+/*
+Adds labels to an issue, leaving its other labels untouched. The "add"
+update operations are used rather than overwriting the labels field, so
+concurrent label edits do not clobber each other.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Usage
+
+	err := jira.AddLabels("FOO-12", "regression", "customer")
+*/
+func (j *Jira) AddLabels(issueKey string, labels ...string) error {
+	return j.AddLabelsCtx(context.Background(), issueKey, labels...)
+}
+
+// AddLabelsCtx is like AddLabels but aborts the request when ctx is done.
+func (j *Jira) AddLabelsCtx(ctx context.Context, issueKey string, labels ...string) error {
+	return j.labelOperations(ctx, issueKey, "add", labels)
+}
+
+/*
+Removes labels from an issue using "remove" update operations, see AddLabels.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Usage
+
+	err := jira.RemoveLabels("FOO-12", "needs-triage")
+*/
+func (j *Jira) RemoveLabels(issueKey string, labels ...string) error {
+	return j.RemoveLabelsCtx(context.Background(), issueKey, labels...)
+}
+
+// RemoveLabelsCtx is like RemoveLabels but aborts the request when ctx is
+// done.
+func (j *Jira) RemoveLabelsCtx(ctx context.Context, issueKey string, labels ...string) error {
+	return j.labelOperations(ctx, issueKey, "remove", labels)
+}
+
+func (j *Jira) labelOperations(ctx context.Context, issueKey, verb string, labels []string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	operations := make([]map[string]interface{}, len(labels))
+	for i, label := range labels {
+		operations[i] = map[string]interface{}{verb: label}
+	}
+
+	return j.UpdateIssueOperationsCtx(ctx, issueKey, map[string][]map[string]interface{}{
+		"labels": operations,
+	})
+}
+
+// IssueOption sets a query parameter of IssueWith.
+type IssueOption func(params Params)
+
+// appendParam adds values to the comma separated list held by key
+func appendParam(params Params, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	if params[key] != "" {
+		values = append([]string{params[key]}, values...)
+	}
+	params[key] = strings.Join(values, ",")
+}
+
+// WithFields limits the fields returned, e.g. "summary", "status" or
+// "*navigable". Every field is returned when not given.
+func WithFields(fields ...string) IssueOption {
+	return func(params Params) { appendParam(params, "fields", fields) }
+}
+
+// WithExpand expands parts of the issue, e.g. "changelog" or "renderedFields".
+func WithExpand(expand ...string) IssueOption {
+	return func(params Params) { appendParam(params, "expand", expand) }
+}
+
+// WithProperties returns the given issue properties, "*all" for every one.
+func WithProperties(properties ...string) IssueOption {
+	return func(params Params) { appendParam(params, "properties", properties) }
+}
+
+/*
+Returns an issue, like Issue but building its query parameters from options.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Parameters
+
+	id   string      The issue id or key
+	opts IssueOption WithFields, WithExpand or WithProperties
+
+Usage
+
+	issue, err := jira.IssueWith("FOO-12",
+		gojira.WithFields("summary", "status"),
+		gojira.WithExpand("changelog"))
+*/
+func (j *Jira) IssueWith(id string, opts ...IssueOption) (*Issue, error) {
+	return j.IssueWithCtx(context.Background(), id, opts...)
+}
+
+// IssueWithCtx is like IssueWith but aborts the request when ctx is done.
+func (j *Jira) IssueWithCtx(ctx context.Context, id string, opts ...IssueOption) (*Issue, error) {
+	var params Params
+	if len(opts) > 0 {
+		params = Params{}
+		for _, opt := range opts {
+			opt(params)
+		}
+	}
+
+	return j.IssueCtx(ctx, id, params)
+}
+
+/*
+Returns an issue along with the HTML rendering of its description and
+comments, in RenderedFields.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}?expand=renderedFields
+
+Parameters
+
+	id string The issue id or key
+
+Usage
+
+	issue, err := jira.IssueRendered("FOO-12")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(issue.RenderedFields.Description)
+*/
+func (j *Jira) IssueRendered(id string) (*Issue, error) {
+	return j.IssueRenderedCtx(context.Background(), id)
+}
+
+// IssueRenderedCtx is like IssueRendered but aborts the request when ctx is
+// done.
+func (j *Jira) IssueRenderedCtx(ctx context.Context, id string) (*Issue, error) {
+	return j.IssueWithCtx(ctx, id, WithExpand("renderedFields"))
+}
+
+/*
+Sets the due date of an issue to the day of date, in its location. A zero
+date clears the due date.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}
+
+Parameters
+
+	issueKey string    The issue id or key
+	date     time.Time The due date, only its day being kept
+
+Usage
+
+	err := jira.SetDueDate("FOO-12", time.Now().AddDate(0, 0, 14))
+*/
+func (j *Jira) SetDueDate(issueKey string, date time.Time) error {
+	return j.SetDueDateCtx(context.Background(), issueKey, date)
+}
+
+// SetDueDateCtx is like SetDueDate but aborts the request when ctx is done.
+func (j *Jira) SetDueDateCtx(ctx context.Context, issueKey string, date time.Time) error {
+	return j.UpdateIssueCtx(ctx, issueKey, map[string]interface{}{"duedate": dueDate(date)})
+}
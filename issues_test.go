@@ -0,0 +1,57 @@
+package gojira
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDeleteIssueWithSubtasks(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/rest/api/2/issue/FOO-12" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("deleteSubtasks"); got != "false" {
+			t.Errorf("deleteSubtasks = %q, want false", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errorMessages":["The issue has subtasks, set deleteSubtasks to true to delete them."],"errors":{}}`))
+	})
+
+	err := jira.DeleteIssue("FOO-12", false)
+
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) {
+		t.Fatalf("expected an *ErrorResponse, got %v", err)
+	}
+	if errResponse.StatusCode != http.StatusBadRequest {
+		t.Errorf("StatusCode = %d, want 400", errResponse.StatusCode)
+	}
+	if len(errResponse.Messages) != 1 {
+		t.Errorf("Messages = %q, want the subtasks message", errResponse.Messages)
+	}
+}
+
+func TestCreateSubtaskCtxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/issue/FOO-12":
+			w.Write([]byte(`{"id":"10012","key":"FOO-12","fields":{"project":{"key":"FOO"}}}`))
+		case "/rest/api/2/issuetype":
+			// the caller gives up while the sub-task type is being looked up
+			cancel()
+			<-r.Context().Done()
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, err := jira.CreateSubtaskCtx(ctx, "FOO-12", "Write the migration", "", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -2,57 +2,266 @@ package gojira
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
+// Doer sends http requests. *http.Client implements it, tests can set
+// Jira.Client to a fake returning canned responses.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 type Jira struct {
 	BaseUrl      string
 	ApiPath      string
 	ActivityPath string
-	Client       *http.Client
+	Client       Doer
 	Auth         *Auth
+	// ApiVersion is the version of the platform api ApiPath points to,
+	// 2 or 3. Version 3, only available on Jira Cloud, exchanges rich text
+	// as ADF documents which are built from and converted to plain text.
+	// Defaults to the version ApiPath ends with, else 2.
+	ApiVersion int
+	// AgilePath is the root of the agile api, defaults to
+	// DefaultAgilePath when empty.
+	AgilePath string
+	// SearchPageSize is the number of issues requested per page by
+	// SearchAll, defaults to 50 when zero.
+	SearchPageSize int
+
+	// MaxRetries is the number of times a request is retried when jira
+	// answers 429 Too Many Requests or 502/503/504, none when zero.
+	// Only GET, HEAD, OPTIONS, PUT and DELETE requests are retried unless
+	// RetryNonIdempotent is set.
+	MaxRetries         int
+	RetryNonIdempotent bool
+
+	// UserAgent is sent with every request, defaults to DefaultUserAgent.
+	UserAgent string
+
+	// MaxResponseBytes caps the size of response bodies, reading more
+	// failing with ErrResponseTooLarge. No limit when zero.
+	MaxResponseBytes int64
+
+	// RequestGzip advertises gzip support with an Accept-Encoding header.
+	// Gzip encoded responses are decompressed whether it is set or not.
+	RequestGzip bool
+
+	// Logger, when set, logs every request sent, retries included, with
+	// the status and duration of its response.
+	Logger Logger
+	// RequestHook and ResponseHook are called around every request sent,
+	// retries included. RequestHook gets a copy of the request with the
+	// Authorization header redacted, ResponseHook the response before its
+	// body is read, which it must not consume.
+	RequestHook  func(req *http.Request)
+	ResponseHook func(resp *http.Response)
+
+	// logical name to field id, see RegisterCustomField
+	customFields map[string]string
+
+	// see LastResponse
+	lastResponseMu sync.Mutex
+	lastResponse   *ResponseMeta
+
+	// see DeploymentType
+	deploymentMu   sync.Mutex
+	deploymentType string
 }
 
 type Auth struct {
 	Login    string
 	Password string
+	// BearerToken holds a Personal Access Token. When set it is sent as
+	// "Authorization: Bearer <token>" and Login/Password are ignored.
+	BearerToken string
+}
+
+// set the authorization header on req, preferring the bearer token.
+// A nil Auth, or one without credentials, leaves req anonymous.
+func (a *Auth) apply(req *http.Request) {
+	switch {
+	case a == nil:
+	case a.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+a.BearerToken)
+	case a.Login != "":
+		req.SetBasicAuth(a.Login, a.Password)
+	}
 }
 
+// Pagination describes a page of results using human page numbers, from
+// 1 to PageCount, Page being 0 when there are no results at all.
+// With MaxResults = 50, StartAt 0 to 49 is page 1, StartAt 50 to 99 is
+// page 2, and so on.
 type Pagination struct {
 	Total      int
 	StartAt    int
 	MaxResults int
 	Page       int
 	PageCount  int
-	Pages      []int
 }
 
 func (p *Pagination) Compute() {
-	p.PageCount = int(math.Ceil(float64(p.Total) / float64(p.MaxResults)))
-	p.Page = int(math.Ceil(float64(p.StartAt) / float64(p.MaxResults)))
+	if p.MaxResults <= 0 {
+		// nothing to divide by, e.g. a search only counting its matches,
+		// everything then stands on a single page
+		p.PageCount = 0
+		if p.Total > 0 {
+			p.PageCount = 1
+		}
+		p.Page = p.PageCount
+	} else {
+		p.PageCount = int(math.Ceil(float64(p.Total) / float64(p.MaxResults)))
+		p.Page = 0
+		if p.PageCount > 0 {
+			p.Page = p.StartAt/p.MaxResults + 1
+			// a startAt past the results, e.g. stale after issues were
+			// deleted, stands on the last page
+			if p.Page > p.PageCount {
+				p.Page = p.PageCount
+			}
+		}
+	}
+}
+
+// PageList returns the page numbers, from 1 to PageCount, e.g. to render
+// page links. The list is built on each call, huge result sets having
+// that many pages.
+func (p *Pagination) PageList() []int {
+	pages := make([]int, p.PageCount)
+	for i := range pages {
+		pages[i] = i + 1
+	}
+
+	return pages
+}
+
+// StartAtOf returns the startAt of a page given its number (1-based).
+func (p *Pagination) StartAtOf(page int) int {
+	if page < 1 {
+		return 0
+	}
+
+	return (page - 1) * p.MaxResults
+}
+
+// HasNext reports whether results remain after the current page.
+func (p *Pagination) HasNext() bool {
+	return p.MaxResults > 0 && p.StartAt+p.MaxResults < p.Total
+}
+
+// HasPrev reports whether results exist before the current page.
+func (p *Pagination) HasPrev() bool {
+	return p.StartAt > 0
+}
 
-	p.Pages = make([]int, p.PageCount)
-	for i := range p.Pages {
-		p.Pages[i] = i
+// NextStartAt returns the startAt of the next page, clamped to Total.
+func (p *Pagination) NextStartAt() int {
+	next := p.StartAt + p.MaxResults
+	if next > p.Total {
+		next = p.Total
 	}
+
+	return next
+}
+
+// PrevStartAt returns the startAt of the previous page, clamped to 0.
+func (p *Pagination) PrevStartAt() int {
+	prev := p.StartAt - p.MaxResults
+	if prev < 0 {
+		prev = 0
+	}
+
+	return prev
 }
 
 type Issue struct {
-	Id        string
-	Key       string
-	Self      string
-	Expand    string
-	Fields    *IssueFields
-	CreatedAt time.Time
+	Id     string
+	Key    string
+	Self   string
+	Expand string
+	Fields *IssueFields
+	// only present when expanded with "changelog"
+	Changelog *Changelog
+	// only present when expanded with "renderedFields"
+	RenderedFields *RenderedFields
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	// midnight UTC of the due date, zero when there is none
+	DueDateAt time.Time
+}
+
+// RenderedFields holds the HTML rendering of the wiki markup fields of an
+// issue. Dates are rendered for display too, e.g. "2 days ago", and are
+// not parsed.
+type RenderedFields struct {
+	Description string        `json:"description"`
+	Environment string        `json:"environment"`
+	Comment     *IssueComment `json:"comment"`
+}
+
+// parse the issue timestamps fields into their time.Time counterparts
+func (issue *Issue) parseTimes() (err error) {
+	if issue.Changelog != nil {
+		err = issue.Changelog.parseTimes(issue.Key)
+	}
+
+	if issue.Fields == nil {
+		return
+	}
+
+	var createdErr error
+	issue.CreatedAt, createdErr = parseJiraTime(issue.Fields.Created)
+	if createdErr != nil && err == nil {
+		err = &TimeParseError{Issue: issue.Key, Field: "created", Value: issue.Fields.Created}
+	}
+
+	var updatedErr error
+	issue.UpdatedAt, updatedErr = parseJiraTime(issue.Fields.Updated)
+	if updatedErr != nil && err == nil {
+		err = &TimeParseError{Issue: issue.Key, Field: "updated", Value: issue.Fields.Updated}
+	}
+
+	// a date without time, unlike created
+	if issue.Fields.DueDate != "" {
+		var dueErr error
+		issue.DueDateAt, dueErr = time.Parse(dayLayout, issue.Fields.DueDate)
+		if dueErr != nil && err == nil {
+			err = &TimeParseError{Issue: issue.Key, Field: "due date", Value: issue.Fields.DueDate}
+		}
+	} else {
+		issue.DueDateAt = time.Time{}
+	}
+
+	if issue.Fields.Comment != nil {
+		if commentErr := issue.Fields.Comment.parseTimes(issue.Key); commentErr != nil && err == nil {
+			err = commentErr
+		}
+	}
+
+	for _, versions := range [][]*Version{issue.Fields.FixVersions, issue.Fields.Versions} {
+		for _, version := range versions {
+			if versionErr := version.parseTimes(); versionErr != nil && err == nil {
+				err = versionErr
+			}
+		}
+	}
+
+	return
 }
 
 type IssueList struct {
@@ -62,36 +271,86 @@ type IssueList struct {
 	Total      int
 	Issues     []*Issue
 	Pagination *Pagination
+	// the sort applied by SearchSorted, nil for other searches
+	Sort *SearchSort `json:"-"`
+}
+
+type SearchSort struct {
+	Field     string
+	Ascending bool
+}
+
+// parse issues timestamps and compute the list pagination, the returned
+// error being the first timestamp which could not be parsed
+func (issues *IssueList) prepare() (err error) {
+	for _, issue := range issues.Issues {
+		if parseErr := issue.parseTimes(); parseErr != nil && err == nil {
+			err = parseErr
+		}
+	}
+
+	pagination := Pagination{
+		Total:      issues.Total,
+		StartAt:    issues.StartAt,
+		MaxResults: issues.MaxResults,
+	}
+	pagination.Compute()
+
+	issues.Pagination = &pagination
+	return
 }
 
+// Sponsor, CodeReviewer, PrimaryDeveloper, QAReviewer and ReleaseManager map
+// custom fields of the instance this client was first written for and are
+// kept for compatibility, other instances should use RegisterCustomField.
 type IssueFields struct {
 	IssueType        *IssueType
 	Summary          string
 	Description      string
 	Status           *IssueStatus
+	Priority         *Priority   `json:"priority"`
+	Resolution       *Resolution `json:"resolution"`
 	Comment          *IssueComment
 	Reporter         *User
 	Assignee         *User
-	Sponsor          *User        `json:"customfield_10300"`
-	CodeReviewer     *User        `json:"customfield_10202"`
-	PrimaryDeveloper *User        `json:"customfield_10203"`
-	QAReviewer       *User        `json:"customfield_12200"`
-	ReleaseManager   *User        `json:"customfield_12300"`
-	Comopnents       []*Component `json:"components"`
-	IssueLinks       []*IssueLink `json:"issuelinks"`
+	Sponsor          *User         `json:"customfield_10300"`
+	CodeReviewer     *User         `json:"customfield_10202"`
+	PrimaryDeveloper *User         `json:"customfield_10203"`
+	QAReviewer       *User         `json:"customfield_12200"`
+	ReleaseManager   *User         `json:"customfield_12300"`
+	Components       []*Component  `json:"components"`
+	Attachments      []*Attachment `json:"attachment"`
+	FixVersions      []*Version    `json:"fixVersions"`
+	Versions         []*Version    `json:"versions"`
+	Labels           []string      `json:"labels"`
+	IssueLinks       []*IssueLink  `json:"issuelinks"`
 	Project          *JiraProject
 	Created          string
+	Updated          string   `json:"updated"`
+	DueDate          string   `json:"duedate"`
+	Watches          *Watches `json:"watches"`
+	// DescriptionADF holds the description document on Jira Cloud,
+	// Description then being its plain text, see ADFToPlainText.
+	DescriptionADF json.RawMessage `json:"-"`
+	// Raw holds every field returned by jira keyed by field id, giving
+	// access to the custom fields of any instance, see CustomField.
+	Raw map[string]json.RawMessage `json:"-"`
 }
 
 type IssueLink struct {
-	Self         string     `json:"self"`
-	Type         *IssueType `json:"type"`
-	InwardIssue  *Issue     `json:"inwardIssue"`
-	OutwardIssue *Issue     `json:"outwardIssue"`
+	Id           string         `json:"id"`
+	Self         string         `json:"self"`
+	Type         *IssueLinkType `json:"type"`
+	InwardIssue  *Issue         `json:"inwardIssue"`
+	OutwardIssue *Issue         `json:"outwardIssue"`
 }
 
 type Component struct {
-	Name string `json:"name"`
+	Self        string `json:"self"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Lead        *User  `json:"lead"`
 }
 
 type IssueType struct {
@@ -104,103 +363,222 @@ type IssueType struct {
 }
 
 type IssueStatus struct {
-	Description string
-	Name        string
+	Self           string          `json:"self"`
+	Id             string          `json:"id"`
+	Description    string          `json:"description"`
+	Name           string          `json:"name"`
+	IconUrl        string          `json:"iconUrl"`
+	StatusCategory *StatusCategory `json:"statusCategory"`
+}
+
+// StatusCategory groups the statuses of every workflow, its Key being one
+// of "new", "indeterminate" or "done" whatever the status is named.
+type StatusCategory struct {
+	Self      string `json:"self"`
+	Id        int    `json:"id"`
+	Key       string `json:"key"`
+	ColorName string `json:"colorName"`
+	Name      string `json:"name"`
 }
 
 type IssueComment struct {
-	Comments []Comment
+	Comments   []Comment
+	StartAt    int `json:"startAt"`
+	MaxResults int `json:"maxResults"`
+	Total      int `json:"total"`
 }
 
 type Comment struct {
-	Author  *User `json:"author"`
-	Body    string
-	Created string
+	Id           string      `json:"id"`
+	Self         string      `json:"self"`
+	Author       *User       `json:"author"`
+	Body         string      `json:"body"`
+	Created      string      `json:"created"`
+	CreatedAt    time.Time   `json:"-"`
+	UpdateAuthor *User       `json:"updateAuthor"`
+	Updated      string      `json:"updated"`
+	UpdatedAt    time.Time   `json:"-"`
+	Visibility   *Visibility `json:"visibility"`
+	// only present when expanded with "renderedBody"
+	RenderedBody string `json:"renderedBody"`
+	// BodyADF holds the body document on Jira Cloud, Body then being
+	// its plain text, see ADFToPlainText.
+	BodyADF json.RawMessage `json:"-"`
+}
+
+// Visibility restricts who can see a comment, Type being "role" or "group"
+// and Value the name of the role or group.
+type Visibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
 type JiraProject struct {
-	Self       string
-	Id         string
-	Key        string
-	Name       string
-	AvatarUrls map[string]string
+	Self           string
+	Id             string
+	Key            string
+	Name           string
+	Description    string
+	Lead           *User
+	ProjectTypeKey string
+	AvatarUrls     map[string]string
+	IssueTypes     []*IssueType
 }
 
 type ActivityItem struct {
-	Title    string    `xml:"title"json:"title"`
-	Id       string    `xml:"id"json:"id"`
-	Link     []Link    `xml:"link"json:"link"`
-	Updated  time.Time `xml:"updated"json:"updated"`
-	Author   Person    `xml:"author"json:"author"`
-	Summary  Text      `xml:"summary"json:"summary"`
-	Category Category  `xml:"category"json:"category"`
+	Title    string    `xml:"title" json:"title"`
+	Id       string    `xml:"id" json:"id"`
+	Link     []Link    `xml:"link" json:"link"`
+	Updated  time.Time `xml:"updated" json:"updated"`
+	Author   Person    `xml:"author" json:"author"`
+	Summary  Text      `xml:"summary" json:"summary"`
+	Category Category  `xml:"category" json:"category"`
 }
 
 type ActivityFeed struct {
-	XMLName xml.Name        `xml:"http://www.w3.org/2005/Atom feed"json:"xml_name"`
-	Title   string          `xml:"title"json:"title"`
-	Id      string          `xml:"id"json:"id"`
-	Link    []Link          `xml:"link"json:"link"`
-	Updated time.Time       `xml:"updated,attr"json:"updated"`
-	Author  Person          `xml:"author"json:"author"`
-	Entries []*ActivityItem `xml:"entry"json:"entries"`
+	XMLName xml.Name        `xml:"http://www.w3.org/2005/Atom feed" json:"xml_name"`
+	Title   string          `xml:"title" json:"title"`
+	Id      string          `xml:"id" json:"id"`
+	Link    []Link          `xml:"link" json:"link"`
+	Updated time.Time       `xml:"updated" json:"updated"`
+	Author  Person          `xml:"author" json:"author"`
+	Entries []*ActivityItem `xml:"entry" json:"entries"`
 }
 
 type Category struct {
-	Term string `xml:"term,attr"json:"term"`
+	Term string `xml:"term,attr" json:"term"`
 }
 
 type Link struct {
-	Rel  string `xml:"rel,attr,omitempty"json:"rel"`
-	Href string `xml:"href,attr"json:"href"`
+	Rel  string `xml:"rel,attr,omitempty" json:"rel"`
+	Href string `xml:"href,attr" json:"href"`
 }
 
 type Person struct {
-	Name     string `xml:"name"json:"name"`
-	URI      string `xml:"uri"json:"uri"`
-	Email    string `xml:"email"json:"email"`
-	InnerXML string `xml:",innerxml"json:"inner_xml"`
+	Name     string `xml:"name" json:"name"`
+	URI      string `xml:"uri" json:"uri"`
+	Email    string `xml:"email" json:"email"`
+	InnerXML string `xml:",innerxml" json:"inner_xml"`
 }
 
 type Text struct {
-	Type string `xml:"type,attr,omitempty"json:"type"`
-	Body string `xml:",chardata"json:"body"`
+	Type string `xml:"type,attr,omitempty" json:"type"`
+	Body string `xml:",chardata" json:"body"`
 }
 
 type Params map[string]string
 
+// Query encodes the params sorted by key, so a given map always yields
+// the same query string.
 func (p Params) Query() string {
 	var buffer bytes.Buffer
 
-	for k, v := range p {
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
 		buffer.WriteString(k)
 		buffer.WriteString("=")
-		buffer.WriteString(url.QueryEscape(v))
+		buffer.WriteString(url.QueryEscape(p[k]))
 		buffer.WriteString("&")
 	}
 
 	return strings.TrimRight(buffer.String(), "&")
 }
 
+// ErrorResponse is the error returned for non 2xx responses. Errors such
+// as ErrVotingDisabled or ErrFilterInaccessible wrap it for the statuses
+// they stand for, so callers test them with errors.Is while the
+// *ErrorResponse remains reachable with errors.As.
 type ErrorResponse struct {
 	Messages   []string          `json:"errorMessages"`
 	Errors     map[string]string `json:"errors"`
 	Status     string
 	StatusCode int
+	// Method and URL of the failed request, the URL stripped of its
+	// query string which may hold JQL or other sensitive values
+	Method string `json:"-"`
+	URL    string `json:"-"`
 }
 
 func (e *ErrorResponse) String() string {
+	if e.Method != "" {
+		return e.Method + " " + e.URL + ": " + e.describe()
+	}
+
+	return e.describe()
+}
+
+func (e *ErrorResponse) describe() string {
 	if len(e.Messages) > 0 {
 		message := e.Messages[0]
 		return e.Status + ": " + message
 	}
 
+	// validation failures only come with field level errors
+	if len(e.Errors) > 0 {
+		fields := make([]string, 0, len(e.Errors))
+		for field := range e.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		details := make([]string, len(fields))
+		for i, field := range fields {
+			details[i] = field + ": " + e.Errors[field]
+		}
+		return e.Status + ": " + strings.Join(details, ", ")
+	}
+
 	return e.Status
 }
 
+// Error implements the error interface so callers can inspect the
+// structured response with errors.As.
+func (e *ErrorResponse) Error() string {
+	return e.String()
+}
+
+// statusError wraps err with sentinel when it is an *ErrorResponse with
+// one of codes, see ErrorResponse, other errors being returned as is.
+func statusError(err error, sentinel error, codes ...int) error {
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) {
+		return err
+	}
+
+	for _, code := range codes {
+		if errResponse.StatusCode == code {
+			return fmt.Errorf("%w: %w", sentinel, errResponse)
+		}
+	}
+
+	return err
+}
+
+// NewJira returns a client using an http.Client which gives up on
+// requests taking longer than DefaultTimeout, attachment downloads only
+// being bounded by their context. auth may be nil to browse public
+// issues anonymously. Its connections are pooled in a transport shared by
+// all the clients created by NewJira, until SetTLSConfig or SetProxy give
+// it a transport of its own.
 func NewJira(baseUrl string, apiPath string, activityPath string, auth *Auth) *Jira {
 
-	client := &http.Client{}
+	client := &http.Client{
+		Timeout:   DefaultTimeout,
+		Transport: sharedTransport,
+	}
+
+	return NewJiraWithClient(baseUrl, apiPath, activityPath, auth, client)
+}
+
+// NewJiraWithClient returns a client sending its requests through the
+// given http.Client, allowing custom timeouts, transports and proxies, or
+// through any other Doer.
+func NewJiraWithClient(baseUrl string, apiPath string, activityPath string, auth *Auth, client Doer) *Jira {
 
 	return &Jira{
 		BaseUrl:      baseUrl,
@@ -211,8 +589,108 @@ func NewJira(baseUrl string, apiPath string, activityPath string, auth *Auth) *J
 	}
 }
 
+/*
+Returns a copy of the client authenticating with auth, sharing the http
+client, and so the connection pool, of j. Use it to act on behalf of
+many users without opening connections for each of them. Settings of
+the copy can be changed independently, but SetTLSConfig and SetProxy
+tune the shared transport and affect both clients. Registered custom
+fields are copied, the last response is not.
+
+Usage
+
+	shared := gojira.NewJira(baseUrl, "/rest/api/2", "/activity", nil)
+	userJira := shared.WithAuth(&gojira.Auth{BearerToken: token})
+*/
+func (j *Jira) WithAuth(auth *Auth) *Jira {
+	clone := &Jira{
+		BaseUrl:            j.BaseUrl,
+		ApiPath:            j.ApiPath,
+		ActivityPath:       j.ActivityPath,
+		Client:             j.Client,
+		Auth:               auth,
+		ApiVersion:         j.ApiVersion,
+		AgilePath:          j.AgilePath,
+		SearchPageSize:     j.SearchPageSize,
+		MaxRetries:         j.MaxRetries,
+		RetryNonIdempotent: j.RetryNonIdempotent,
+		UserAgent:          j.UserAgent,
+		MaxResponseBytes:   j.MaxResponseBytes,
+		RequestGzip:        j.RequestGzip,
+		Logger:             j.Logger,
+		RequestHook:        j.RequestHook,
+		ResponseHook:       j.ResponseHook,
+	}
+
+	for name, fieldID := range j.customFields {
+		clone.RegisterCustomField(name, fieldID)
+	}
+
+	// the deployment type is the same whoever asks
+	j.deploymentMu.Lock()
+	clone.deploymentType = j.deploymentType
+	j.deploymentMu.Unlock()
+
+	return clone
+}
+
+// TimeParseError reports a timestamp jira sent in an unexpected format,
+// the matching time field being left to the zero time. Issue is empty for
+// timestamps not belonging to an issue.
+type TimeParseError struct {
+	Issue string
+	Field string
+	Value string
+}
+
+func (e *TimeParseError) Error() string {
+	message := "unexpected " + e.Field + " time format: " + e.Value
+	if e.Issue == "" {
+		return message
+	}
+
+	return "issue " + e.Issue + ": " + message
+}
+
+// layouts tried in turn by parseJiraTime, the fractional seconds being
+// optional and of any precision when parsing a layout without them
+var dateLayouts = []string{
+	dateLayout,
+	"2006-01-02T15:04:05-0700",
+	// agile api and RFC 3339, e.g. "2019-02-04T10:10:12.118+01:00"
+	time.RFC3339,
+	// cloud, e.g. "2019-02-04T10:10:12.118Z" or "...+0100"
+	"2006-01-02T15:04:05Z0700",
+	// date only fields, such as due and release dates
+	dayLayout,
+}
+
+// parseJiraTime parses a jira timestamp, an empty value giving the zero time
+func parseJiraTime(value string) (t time.Time, err error) {
+	if value == "" {
+		return
+	}
+
+	for _, layout := range dateLayouts {
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			return
+		}
+	}
+
+	return
+}
+
 const (
 	dateLayout = "2006-01-02T15:04:05.000-0700"
+	// layout of date only fields, such as release dates
+	dayLayout = "2006-01-02"
+
+	// DefaultTimeout is the http timeout of clients created by NewJira
+	DefaultTimeout = 30 * time.Second
+
+	ClientVersion    = "0.1.0"
+	DefaultUserAgent = "go-jira-client/" + ClientVersion
 )
 
 func okStatus(code int) bool {
@@ -224,44 +702,230 @@ func okStatus(code int) bool {
 	return false
 }
 
-func (j *Jira) buildAndExecRequest(method string, url string) (contents []byte, err error) {
+func (j *Jira) buildAndExecRequestCtx(ctx context.Context, method string, url string) (contents []byte, err error) {
 
-	req, err := http.NewRequest(method, url, nil)
+	req, err := j.newRequest(ctx, method, url, nil)
 	if err != nil {
-		err = errors.New("Error while building jira request")
 		return
 	}
-	req.SetBasicAuth(j.Auth.Login, j.Auth.Password)
 
-	resp, err := j.Client.Do(req)
+	return j.execRequest(req)
+}
+
+// same as buildAndExecRequestCtx, sending payload encoded as json
+func (j *Jira) buildAndExecJSONRequestCtx(ctx context.Context, method string, url string, payload interface{}) (contents []byte, err error) {
+
+	req, err := j.newRequest(ctx, method, url, payload)
+	if err != nil {
+		return
+	}
+
+	return j.execRequest(req)
+}
+
+// newRequest builds a request sending payload encoded as json,
+// without body when payload is nil
+func (j *Jira) newRequest(ctx context.Context, method string, url string, payload interface{}) (*http.Request, error) {
+	if payload == nil {
+		req, err := http.NewRequestWithContext(ctx, method, url, nil)
+		if err != nil {
+			return nil, errors.New("Error while building jira request")
+		}
+		return req, nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.New("Error while building jira request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// execRequest authenticates and sends req, returning the response body.
+// Non 2xx responses are returned as *ErrorResponse.
+func (j *Jira) execRequest(req *http.Request) (contents []byte, err error) {
+	resp, err := j.doRequest(req)
+	if err != nil {
+		return
+	}
 	defer resp.Body.Close()
-	contents, err = ioutil.ReadAll(resp.Body)
 
-	if !okStatus(resp.StatusCode) {
-		errResponse := new(ErrorResponse)
-		err = json.Unmarshal(contents, &errResponse)
-		errResponse.Status = resp.Status
-		errResponse.StatusCode = resp.StatusCode
+	contents, err = ioutil.ReadAll(j.limitBody(resp.Body))
+	if err != nil {
+		// the query is left out as it may hold sensitive jql
+		return nil, fmt.Errorf("reading response body of %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	return
+}
+
+// decodeRequest authenticates and sends req, decoding its json response
+// into v straight from the body rather than buffering it whole.
+func (j *Jira) decodeRequest(req *http.Request, v interface{}) error {
+	resp, err := j.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err = json.NewDecoder(j.limitBody(resp.Body)).Decode(v); err != nil {
+		return fmt.Errorf("decoding response body of %s %s: %w", req.Method, req.URL.Path, err)
+	}
 
+	return nil
+}
+
+// url returns the url of an endpoint, apiRoot being ApiPath, ActivityPath
+// or the agile root and the endpoint path built from pathFmt and args as
+// with fmt.Sprintf. String args are path segments and get escaped, so a key
+// holding "/" or "?" cannot point the request at another endpoint.
+func (j *Jira) url(apiRoot string, pathFmt string, args ...interface{}) string {
+	path := pathFmt
+	if len(args) > 0 {
+		segments := make([]interface{}, len(args))
+		for i, arg := range args {
+			if s, ok := arg.(string); ok {
+				arg = url.PathEscape(s)
+			}
+			segments[i] = arg
+		}
+		path = fmt.Sprintf(pathFmt, segments...)
+	}
+
+	return j.BaseUrl + apiRoot + path
+}
+
+// ApiPathFor returns the path of a version of the platform api, e.g.
+// "/rest/api/3", to be used as ApiPath.
+func ApiPathFor(version int) string {
+	return "/rest/api/" + strconv.Itoa(version)
+}
+
+// apiVersion returns ApiVersion, or else the version ApiPath ends with
+func (j *Jira) apiVersion() int {
+	if j.ApiVersion > 0 {
+		return j.ApiVersion
+	}
+
+	path := strings.TrimRight(j.ApiPath, "/")
+	if version, err := strconv.Atoi(path[strings.LastIndex(path, "/")+1:]); err == nil && version > 0 {
+		return version
+	}
+
+	return 2
+}
+
+// sameOrigin reports whether u has the scheme and host of base
+func sameOrigin(base *url.URL, u *url.URL) bool {
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// redactedUrl returns u without its query string nor credentials
+func redactedUrl(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = ""
+	redacted.ForceQuery = false
+	return redacted.String()
+}
+
+// doRequest authenticates and sends req, leaving the body of successful
+// responses open for the caller to consume and close.
+// Non 2xx responses are returned as *ErrorResponse.
+func (j *Jira) doRequest(req *http.Request) (resp *http.Response, err error) {
+	// without Auth requests are either anonymous or authenticated by the
+	// client transport, as done by OAuthTransport
+	j.Auth.apply(req)
+
+	return j.sendRequest(j.Client, req)
+}
+
+// sendRequest is doRequest without Auth, sending req through client
+func (j *Jira) sendRequest(client Doer, req *http.Request) (resp *http.Response, err error) {
+	userAgent := j.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	if j.RequestGzip {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	for attempt := 0; ; attempt++ {
+		j.traceRequest(req)
+		start := time.Now()
+		resp, err = client.Do(req)
+		j.traceResponse(req, resp, err, start)
 		if err != nil {
 			return
 		}
 
-		err = errors.New(errResponse.String())
-		return
+		if attempt >= j.MaxRetries || !retryableStatus(resp.StatusCode) || !j.canRetry(req) {
+			break
+		}
+
+		delay := retryDelay(resp, attempt)
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err = sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	j.recordResponse(resp)
+
+	if err = decompress(resp); err != nil {
+		return nil, err
+	}
+
+	if !okStatus(resp.StatusCode) {
+		defer resp.Body.Close()
+		// a body past MaxResponseBytes is cut, the status is still reported
+		contents, _ := ioutil.ReadAll(j.limitBody(resp.Body))
+
+		errResponse := new(ErrorResponse)
+		// the body is not always json (proxies, html error pages),
+		// the status alone is still worth reporting in that case
+		json.Unmarshal(contents, errResponse)
+		errResponse.Status = resp.Status
+		errResponse.StatusCode = resp.StatusCode
+		errResponse.Method = req.Method
+		errResponse.URL = redactedUrl(req.URL)
+
+		return nil, errResponse
 	}
 
 	return
 }
 
 func (j *Jira) UserActivity(user string) (ActivityFeed, error) {
-	url := j.BaseUrl + j.ActivityPath + "?streams=" + url.QueryEscape("user IS "+user)
+	return j.UserActivityCtx(context.Background(), user)
+}
+
+func (j *Jira) UserActivityCtx(ctx context.Context, user string) (ActivityFeed, error) {
+	return j.ActivityCtx(ctx, j.activityUrl(map[string]string{"user": user}))
+}
 
-	return j.Activity(url)
+func (j *Jira) Activity(url string) (ActivityFeed, error) {
+	return j.ActivityCtx(context.Background(), url)
 }
 
-func (j *Jira) Activity(url string) (activity ActivityFeed, err error) {
-	contents, err := j.buildAndExecRequest("GET", url)
+func (j *Jira) ActivityCtx(ctx context.Context, url string) (activity ActivityFeed, err error) {
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
 	if err != nil {
 		return
 	}
@@ -271,50 +935,59 @@ func (j *Jira) Activity(url string) (activity ActivityFeed, err error) {
 }
 
 // search issues assigned to given user
-func (j *Jira) IssuesAssignedTo(user string, maxResults int, startAt int) (issues IssueList, err error) {
+func (j *Jira) IssuesAssignedTo(user string, maxResults int, startAt int) (IssueList, error) {
+	return j.IssuesAssignedToCtx(context.Background(), user, maxResults, startAt)
+}
 
-	url := j.BaseUrl + j.ApiPath + "/search?jql=assignee=\"" + url.QueryEscape(user) + "\"&startAt=" + strconv.Itoa(startAt) + "&maxResults=" + strconv.Itoa(maxResults)
-	contents, err := j.buildAndExecRequest("GET", url)
-	if err != nil {
-		return
-	}
+// search issues assigned to given user, aborting when ctx is done
+func (j *Jira) IssuesAssignedToCtx(ctx context.Context, user string, maxResults int, startAt int) (IssueList, error) {
+	return j.IssuesAssignedToWithExpandCtx(ctx, user, maxResults, startAt)
+}
 
-	err = json.Unmarshal(contents, &issues)
-	if err != nil {
-		return
-	}
+// search issues assigned to given user, expanding e.g. "changelog" or
+// "renderedFields" on each of them
+func (j *Jira) IssuesAssignedToWithExpand(user string, maxResults int, startAt int, expand ...string) (IssueList, error) {
+	return j.IssuesAssignedToWithExpandCtx(context.Background(), user, maxResults, startAt, expand...)
+}
 
-	for _, issue := range issues.Issues {
-		t, _ := time.Parse(dateLayout, issue.Fields.Created)
-		issue.CreatedAt = t
+// IssuesAssignedToWithExpandCtx is like IssuesAssignedToWithExpand but
+// aborts the request when ctx is done.
+func (j *Jira) IssuesAssignedToWithExpandCtx(ctx context.Context, user string, maxResults int, startAt int, expand ...string) (IssueList, error) {
+	jql := NewJQL()
+	if isJQLFunc(user) {
+		// e.g. currentUser()
+		jql.Func("assignee", user)
+	} else {
+		jql.Eq("assignee", user)
 	}
 
-	pagination := Pagination{
-		Total:      issues.Total,
-		StartAt:    issues.StartAt,
-		MaxResults: issues.MaxResults,
-	}
-	pagination.Compute()
-
-	issues.Pagination = &pagination
-
-	return
+	return j.searchPage(ctx, jql.String(), startAt, maxResults, []string{"*navigable"}, expand)
 }
 
 // search an issue by its id
-func (j *Jira) Issue(id string, params Params) (issue *Issue, err error) {
+func (j *Jira) Issue(id string, params Params) (*Issue, error) {
+	return j.IssueCtx(context.Background(), id, params)
+}
 
-	url := j.BaseUrl + j.ApiPath + "/issue/" + id
+// search an issue by its id, aborting when ctx is done
+func (j *Jira) IssueCtx(ctx context.Context, id string, params Params) (issue *Issue, err error) {
+
+	url := j.url(j.ApiPath, issue_url+"/%s", id)
 
 	if params != nil {
 		url += "?" + params.Query()
 	}
 
-	contents, err := j.buildAndExecRequest("GET", url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
 	if err != nil {
 		return
 	}
 
 	err = json.Unmarshal(contents, &issue)
+	if err != nil {
+		return
+	}
+
+	err = issue.parseTimes()
 	return
 }
@@ -0,0 +1,183 @@
+package gojira
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestJira returns a client of a test server answering with handler,
+// the server being closed at the end of the test.
+func newTestJira(t *testing.T, handler http.HandlerFunc) *Jira {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewJiraWithClient(server.URL, "/rest/api/2", "/activity", &Auth{Login: "login", Password: "password"}, server.Client())
+}
+
+func TestIssueConnectionClosed(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		conn.Close()
+	})
+
+	issue, err := jira.Issue("FOO-12", nil)
+	if err == nil {
+		t.Fatal("expected an error when the server closes the connection")
+	}
+	if issue != nil {
+		t.Errorf("expected no issue, got %+v", issue)
+	}
+}
+
+func TestPaginationNavigation(t *testing.T) {
+	tests := []struct {
+		name                     string
+		pagination               Pagination
+		hasNext, hasPrev         bool
+		nextStartAt, prevStartAt int
+	}{
+		{"empty result", Pagination{Total: 0, StartAt: 0, MaxResults: 50}, false, false, 0, 0},
+		{"single page", Pagination{Total: 10, StartAt: 0, MaxResults: 50}, false, false, 10, 0},
+		{"exactly one page", Pagination{Total: 50, StartAt: 0, MaxResults: 50}, false, false, 50, 0},
+		{"first page", Pagination{Total: 120, StartAt: 0, MaxResults: 50}, true, false, 50, 0},
+		{"middle page", Pagination{Total: 120, StartAt: 50, MaxResults: 50}, true, true, 100, 0},
+		{"last page", Pagination{Total: 120, StartAt: 100, MaxResults: 50}, false, true, 120, 50},
+	}
+
+	for _, test := range tests {
+		p := test.pagination
+		if got := p.HasNext(); got != test.hasNext {
+			t.Errorf("%s: HasNext() = %v, want %v", test.name, got, test.hasNext)
+		}
+		if got := p.HasPrev(); got != test.hasPrev {
+			t.Errorf("%s: HasPrev() = %v, want %v", test.name, got, test.hasPrev)
+		}
+		if got := p.NextStartAt(); got != test.nextStartAt {
+			t.Errorf("%s: NextStartAt() = %d, want %d", test.name, got, test.nextStartAt)
+		}
+		if got := p.PrevStartAt(); got != test.prevStartAt {
+			t.Errorf("%s: PrevStartAt() = %d, want %d", test.name, got, test.prevStartAt)
+		}
+	}
+}
+
+func TestPaginationComputeWithoutMaxResults(t *testing.T) {
+	p := Pagination{Total: 42, StartAt: 0, MaxResults: 0}
+	p.Compute()
+
+	if p.PageCount != 1 || p.Page != 1 {
+		t.Errorf("got page %d of %d, want 1 of 1", p.Page, p.PageCount)
+	}
+	if p.HasNext() {
+		t.Error("HasNext() = true without MaxResults")
+	}
+
+	empty := Pagination{Total: 0, MaxResults: 0}
+	empty.Compute()
+
+	if empty.PageCount != 0 || empty.Page != 0 {
+		t.Errorf("got page %d of %d, want 0 of 0", empty.Page, empty.PageCount)
+	}
+	if pages := empty.PageList(); len(pages) != 0 {
+		t.Errorf("PageList() = %v, want no pages", pages)
+	}
+}
+
+func TestPaginationComputePage(t *testing.T) {
+	tests := []struct {
+		total, startAt, maxResults int
+		page, pageCount            int
+	}{
+		{0, 0, 50, 0, 0},
+		{10, 0, 50, 1, 1},
+		{100, 0, 50, 1, 2},
+		{100, 49, 50, 1, 2},
+		{100, 50, 50, 2, 2},
+		{101, 100, 50, 3, 3},
+		// past the results
+		{100, 150, 50, 2, 2},
+	}
+
+	for _, test := range tests {
+		p := Pagination{Total: test.total, StartAt: test.startAt, MaxResults: test.maxResults}
+		p.Compute()
+
+		if p.Page != test.page || p.PageCount != test.pageCount {
+			t.Errorf("Total %d StartAt %d MaxResults %d: got page %d of %d, want %d of %d",
+				test.total, test.startAt, test.maxResults, p.Page, p.PageCount, test.page, test.pageCount)
+		}
+	}
+}
+
+func TestParamsQuery(t *testing.T) {
+	params := Params{
+		"maxResults": "10",
+		"jql":        `project = FOO AND status = "In Progress"`,
+		"expand":     "changelog,renderedFields",
+	}
+
+	want := "expand=changelog%2CrenderedFields&jql=project+%3D+FOO+AND+status+%3D+%22In+Progress%22&maxResults=10"
+	for i := 0; i < 10; i++ {
+		if got := params.Query(); got != want {
+			t.Fatalf("Query() = %q, want %q", got, want)
+		}
+	}
+
+	if got := (Params{}).Query(); got != "" {
+		t.Errorf("empty Query() = %q, want an empty string", got)
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	notFound := &ErrorResponse{Status: "404 Not Found", StatusCode: http.StatusNotFound}
+
+	err := statusError(notFound, ErrCommentNotFound, http.StatusNotFound)
+	if !errors.Is(err, ErrCommentNotFound) {
+		t.Errorf("expected ErrCommentNotFound, got %v", err)
+	}
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) || errResponse.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the 404 *ErrorResponse to remain reachable, got %v", err)
+	}
+
+	if err := statusError(notFound, ErrCommentForbidden, http.StatusForbidden); err != error(notFound) {
+		t.Errorf("expected other statuses to be returned as is, got %v", err)
+	}
+
+	if err := commentError(notFound); !errors.Is(err, ErrCommentNotFound) || errors.Is(err, ErrCommentForbidden) {
+		t.Errorf("expected ErrCommentNotFound only, got %v", err)
+	}
+}
+
+func TestUrlEscapesPathSegments(t *testing.T) {
+	var path string
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Write([]byte(`{"value": true}`))
+	})
+
+	if _, err := jira.IssueProperty("FOO-1/../../myself", "my key/?x"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "/rest/api/2/issue/FOO-1%2F..%2F..%2Fmyself/properties/my%20key%2F%3Fx"
+	if path != expected {
+		t.Errorf("expected path %s, got %s", expected, path)
+	}
+}
+
+func TestUrlKeepsIntArgs(t *testing.T) {
+	jira := NewJira("http://example.com", "/rest/api/2", "/activity", nil)
+
+	if url := jira.url(jira.ApiPath, "/board/%d/sprint", 42); url != "http://example.com/rest/api/2/board/42/sprint" {
+		t.Errorf("unexpected url %s", url)
+	}
+}
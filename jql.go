@@ -0,0 +1,183 @@
+package gojira
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// field names which can be used in JQL without quotes
+var jqlFieldPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_.]*|cf\[[0-9]+\])$`)
+
+// function calls such as currentUser(), startOfDay(-1d) or
+// membersOf("jira-users"), arguments being quoted strings or plain words
+var jqlFuncPattern = regexp.MustCompile(`^[A-Za-z]+\(\s*((` + jqlFuncArg + `)(\s*,\s*(` + jqlFuncArg + `))*)?\s*\)$`)
+
+const jqlFuncArg = `"(\\.|[^"\\])*"|'(\\.|[^'\\])*'|[A-Za-z0-9_.+-]+`
+
+// isJQLFunc tells whether value is a single JQL function call
+func isJQLFunc(value string) bool {
+	return jqlFuncPattern.MatchString(strings.TrimSpace(value))
+}
+
+var jqlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	`'`, `\'`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// QuoteJQL returns value as a JQL string literal, escaping quotes and
+// backslashes so it cannot alter the structure of the query.
+func QuoteJQL(value string) string {
+	return `"` + jqlEscaper.Replace(value) + `"`
+}
+
+// operators accepted by JQL.Op and JQL.FuncOp, CHANGED being left out as
+// it takes predicates rather than a value
+var jqlOperators = map[string]bool{
+	"=": true, "!=": true, "~": true, "!~": true,
+	"<": true, "<=": true, ">": true, ">=": true,
+	"IN": true, "NOT IN": true,
+	"IS": true, "IS NOT": true,
+	"WAS": true, "WAS NOT": true, "WAS IN": true, "WAS NOT IN": true,
+}
+
+// operator adds the error of an operator which is not a JQL operator to
+// q, and otherwise returns it upper cased with single spaces. An invalid
+// operator is quoted, so that the query is rejected by jira rather than
+// rewritten by an operator coming from user input.
+func (q *JQL) operator(operator string) string {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(operator), " "))
+	if !jqlOperators[normalized] {
+		if q.err == nil {
+			q.err = errors.New("invalid JQL operator " + strconv.Quote(operator))
+		}
+		return QuoteJQL(operator)
+	}
+
+	return normalized
+}
+
+// quote field names which are not plain identifiers, e.g. "Story Points"
+func jqlField(field string) string {
+	if jqlFieldPattern.MatchString(field) {
+		return field
+	}
+
+	return QuoteJQL(field)
+}
+
+/*
+JQL builds a query out of clauses joined with AND, values being always
+quoted and escaped. An invalid operator given to Op or FuncOp is reported
+by Err and Build.
+
+Usage
+
+	jql, err := gojira.NewJQL().
+		Eq("project", "FOO").
+		In("status", "Open", "In Progress").
+		Op("created", ">=", since).
+		Build()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	// project = "FOO" AND status IN ("Open", "In Progress") AND created >= "-1w"
+*/
+type JQL struct {
+	clauses []string
+	err     error
+}
+
+func NewJQL() *JQL {
+	return &JQL{}
+}
+
+// Op adds a "field operator value" clause, e.g. Op("created", ">=", "-1w").
+// operator must be one of =, !=, ~, !~, <, <=, >, >=, IN, NOT IN, IS,
+// IS NOT, WAS, WAS NOT, WAS IN and WAS NOT IN, any other being reported
+// by Err and Build.
+func (q *JQL) Op(field, operator, value string) *JQL {
+	q.clauses = append(q.clauses, jqlField(field)+" "+q.operator(operator)+" "+QuoteJQL(value))
+	return q
+}
+
+// Eq adds a "field = value" clause.
+func (q *JQL) Eq(field, value string) *JQL {
+	return q.Op(field, "=", value)
+}
+
+// NotEq adds a "field != value" clause.
+func (q *JQL) NotEq(field, value string) *JQL {
+	return q.Op(field, "!=", value)
+}
+
+// Contains adds a "field ~ value" text search clause.
+func (q *JQL) Contains(field, value string) *JQL {
+	return q.Op(field, "~", value)
+}
+
+// In adds a "field IN (values...)" clause.
+func (q *JQL) In(field string, values ...string) *JQL {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = QuoteJQL(value)
+	}
+
+	q.clauses = append(q.clauses, jqlField(field)+" IN ("+strings.Join(quoted, ", ")+")")
+	return q
+}
+
+// Func adds a "field = fn" clause, fn being a JQL function call left
+// unquoted, e.g. Func("assignee", "currentUser()"). Anything else than a
+// single function call with literal arguments is quoted as a string
+// rather than injected in the query.
+func (q *JQL) Func(field, fn string) *JQL {
+	return q.FuncOp(field, "=", fn)
+}
+
+// FuncOp adds a "field operator fn" clause, e.g.
+// FuncOp("assignee", "IN", `membersOf("jira-users")`), see Func. operator
+// is checked as by Op.
+func (q *JQL) FuncOp(field, operator, fn string) *JQL {
+	operator = q.operator(operator)
+	value := QuoteJQL(fn)
+	if isJQLFunc(fn) {
+		value = strings.TrimSpace(fn)
+	}
+
+	q.clauses = append(q.clauses, jqlField(field)+" "+operator+" "+value)
+	return q
+}
+
+// Raw adds a clause as is, wrapped in parentheses. It must not contain
+// untrusted input.
+func (q *JQL) Raw(clause string) *JQL {
+	q.clauses = append(q.clauses, "("+clause+")")
+	return q
+}
+
+// Err returns the first invalid operator given to Op or FuncOp, if any.
+func (q *JQL) Err() error {
+	return q.err
+}
+
+// Build returns the query along with the error returned by Err, the query
+// being empty when there is one.
+func (q *JQL) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+
+	return q.String(), nil
+}
+
+// String returns the query, an invalid operator being left quoted so that
+// jira rejects it, see Build to check the query before sending it.
+func (q *JQL) String() string {
+	return strings.Join(q.clauses, " AND ")
+}
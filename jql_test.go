@@ -0,0 +1,49 @@
+package gojira
+
+import "testing"
+
+func TestJQLOperators(t *testing.T) {
+	tests := []struct {
+		operator string
+		want     string
+	}{
+		{"=", `created = "-1w"`},
+		{">=", `created >= "-1w"`},
+		{"not  in", `created NOT IN "-1w"`},
+		{"was not", `created WAS NOT "-1w"`},
+	}
+
+	for _, test := range tests {
+		if got := NewJQL().Op("created", test.operator, "-1w").String(); got != test.want {
+			t.Errorf("Op(%q) = %s, want %s", test.operator, got, test.want)
+		}
+	}
+
+	if got := NewJQL().FuncOp("assignee", "in", `membersOf("jira-users")`).String(); got != `assignee IN membersOf("jira-users")` {
+		t.Errorf("FuncOp = %s", got)
+	}
+}
+
+func TestJQLInvalidOperator(t *testing.T) {
+	for _, operator := range []string{"", `= "FOO" OR project`, "CHANGED", "==", "ORDER BY"} {
+		jql := NewJQL().Eq("type", "Bug").Op("project", operator, "FOO")
+		if jql.Err() == nil {
+			t.Errorf("Op(%q): expected an error", operator)
+		}
+		if query, err := jql.Build(); err == nil || query != "" {
+			t.Errorf("Op(%q): Build() = %q, %v, want an error", operator, query, err)
+		}
+		// the operator is quoted rather than injected, or the clause dropped
+		if want := `type = "Bug" AND project ` + QuoteJQL(operator) + ` "FOO"`; jql.String() != want {
+			t.Errorf("Op(%q): String() = %s, want %s", operator, jql.String(), want)
+		}
+
+		if NewJQL().FuncOp("assignee", operator, "currentUser()").Err() == nil {
+			t.Errorf("FuncOp(%q): expected an error", operator)
+		}
+	}
+
+	if _, err := NewJQL().Op("created", ">=", "-1w").Build(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
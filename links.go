@@ -0,0 +1,128 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	issue_link_url      = "/issueLink"
+	issue_link_type_url = "/issueLinkType"
+)
+
+// IssueLinkType describes a kind of link, Inward and Outward being the
+// phrasing used from each side, e.g. "is blocked by" and "blocks".
+type IssueLinkType struct {
+	Id      string `json:"id"`
+	Self    string `json:"self"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+/*
+Returns the link types available on the instance, whose names can be used with LinkIssues.
+
+	GET http://example.com:8080/jira/rest/api/2/issueLinkType
+
+Usage
+
+	linkTypes, err := jira.LinkTypes()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, linkType := range linkTypes {
+		fmt.Println(linkType.Name, linkType.Outward)
+	}
+*/
+func (j *Jira) LinkTypes() (linkTypes []*IssueLinkType, err error) {
+	return j.LinkTypesCtx(context.Background())
+}
+
+// LinkTypesCtx is like LinkTypes but aborts the request when ctx is done.
+func (j *Jira) LinkTypesCtx(ctx context.Context) (linkTypes []*IssueLinkType, err error) {
+	url := j.url(j.ApiPath, issue_link_type_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	list := struct {
+		IssueLinkTypes []*IssueLinkType `json:"issueLinkTypes"`
+	}{}
+	err = json.Unmarshal(contents, &list)
+	if err != nil {
+		return
+	}
+
+	linkTypes = list.IssueLinkTypes
+	return
+}
+
+/*
+Links two issues, reading as "outwardKey <outward> inwardKey",
+e.g. "FOO-1 blocks FOO-2" for a "Blocks" link.
+
+	POST http://example.com:8080/jira/rest/api/2/issueLink
+
+Parameters
+
+	inwardKey  string The key of the inward issue
+	outwardKey string The key of the outward issue
+	linkType   string The name of the link type, e.g. "Blocks" or "Relates"
+
+Usage
+
+	// FOO-1 blocks FOO-2
+	err := jira.LinkIssues("FOO-2", "FOO-1", "Blocks")
+*/
+func (j *Jira) LinkIssues(inwardKey, outwardKey, linkType string) (err error) {
+	return j.LinkIssuesCtx(context.Background(), inwardKey, outwardKey, linkType)
+}
+
+// LinkIssuesCtx is like LinkIssues but aborts the request when ctx is done.
+func (j *Jira) LinkIssuesCtx(ctx context.Context, inwardKey, outwardKey, linkType string) (err error) {
+	if linkType == "" {
+		return errors.New("link type is required to link issues")
+	}
+
+	payload := map[string]interface{}{
+		"type":         map[string]string{"name": linkType},
+		"inwardIssue":  map[string]string{"key": inwardKey},
+		"outwardIssue": map[string]string{"key": outwardKey},
+	}
+
+	url := j.url(j.ApiPath, issue_link_url)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	return
+}
+
+/*
+Deletes a link between two issues.
+
+	DELETE http://example.com:8080/jira/rest/api/2/issueLink/{linkId}
+
+Parameters
+
+	linkId string The id of the link, as found in IssueFields.IssueLinks
+
+Usage
+
+	err := jira.DeleteIssueLink(issue.Fields.IssueLinks[0].Id)
+*/
+func (j *Jira) DeleteIssueLink(linkId string) (err error) {
+	return j.DeleteIssueLinkCtx(context.Background(), linkId)
+}
+
+// DeleteIssueLinkCtx is like DeleteIssueLink but aborts the request when ctx
+// is done.
+func (j *Jira) DeleteIssueLinkCtx(ctx context.Context, linkId string) (err error) {
+	if linkId == "" {
+		return errors.New("link id is required to delete an issue link")
+	}
+
+	url := j.url(j.ApiPath, issue_link_url+"/%s", linkId)
+	_, err = j.buildAndExecRequestCtx(ctx, "DELETE", url)
+	return
+}
@@ -0,0 +1,180 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+const (
+	createmeta_url = "/issue/createmeta"
+	editmeta_url   = "/editmeta"
+)
+
+// FieldSchema describes the type of a field, Items being the type of the
+// elements of array fields and Custom the plugin type of custom fields.
+type FieldSchema struct {
+	Type     string `json:"type"`
+	Items    string `json:"items"`
+	System   string `json:"system"`
+	Custom   string `json:"custom"`
+	CustomId int    `json:"customId"`
+}
+
+// AllowedValue is one of the values a field accepts. Depending on the field
+// it is identified by its Name (components, versions, priorities...) or by
+// its Value (select list options).
+type AllowedValue struct {
+	Self        string `json:"self"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+type FieldMeta struct {
+	Key             string          `json:"key"`
+	Name            string          `json:"name"`
+	Required        bool            `json:"required"`
+	Schema          *FieldSchema    `json:"schema"`
+	HasDefaultValue bool            `json:"hasDefaultValue"`
+	DefaultValue    json.RawMessage `json:"defaultValue"`
+	Operations      []string        `json:"operations"`
+	AllowedValues   []*AllowedValue `json:"allowedValues"`
+	AutoCompleteUrl string          `json:"autoCompleteUrl"`
+}
+
+type CreateMeta struct {
+	Projects []*CreateMetaProject `json:"projects"`
+}
+
+type CreateMetaProject struct {
+	Self       string                 `json:"self"`
+	Id         string                 `json:"id"`
+	Key        string                 `json:"key"`
+	Name       string                 `json:"name"`
+	IssueTypes []*CreateMetaIssueType `json:"issuetypes"`
+}
+
+// CreateMetaIssueType is an issue type along with the fields of its create
+// screen, keyed by field id.
+type CreateMetaIssueType struct {
+	IssueType
+	Fields map[string]*FieldMeta `json:"fields"`
+}
+
+// MissingFields returns the ids of the required fields, without default
+// value, which are not set in fields. The project and issue type are
+// left out as CreateIssue always sets them.
+func (t *CreateMetaIssueType) MissingFields(fields map[string]interface{}) []string {
+	missing := []string{}
+	for id, field := range t.Fields {
+		if !field.Required || field.HasDefaultValue || id == "project" || id == "issuetype" {
+			continue
+		}
+		if _, ok := fields[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
+
+/*
+Returns the fields of the create screen of an issue type in a project,
+including whether they are required, their schema and their allowed values.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/createmeta?projectKeys=FOO&issuetypeNames=Bug&expand=projects.issuetypes.fields
+
+Parameters
+
+	projectKey    string The key of the project
+	issueTypeName string The name of the issue type, all issue types of the project when empty
+
+Usage
+
+	meta, err := jira.CreateMeta("FOO", "Bug")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	issueType := meta.Projects[0].IssueTypes[0]
+	for id, field := range issueType.Fields {
+		fmt.Println(id, field.Name, field.Required, field.Schema.Type)
+	}
+	fmt.Println(issueType.MissingFields(fields))
+*/
+func (j *Jira) CreateMeta(projectKey, issueTypeName string) (meta *CreateMeta, err error) {
+	return j.CreateMetaCtx(context.Background(), projectKey, issueTypeName)
+}
+
+// CreateMetaCtx is like CreateMeta but aborts the request when ctx is done.
+func (j *Jira) CreateMetaCtx(ctx context.Context, projectKey, issueTypeName string) (meta *CreateMeta, err error) {
+	params := Params{
+		"projectKeys": projectKey,
+		"expand":      "projects.issuetypes.fields",
+	}
+	if issueTypeName != "" {
+		params["issuetypeNames"] = issueTypeName
+	}
+
+	url := j.url(j.ApiPath, createmeta_url) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	meta = &CreateMeta{}
+	err = json.Unmarshal(contents, meta)
+	return
+}
+
+// EditMeta holds the fields the current user can edit on an issue, keyed by
+// field id, in the issue current workflow state.
+type EditMeta struct {
+	Fields map[string]*FieldMeta `json:"fields"`
+}
+
+// Editable reports whether the field with the given id can be edited.
+func (m *EditMeta) Editable(fieldId string) bool {
+	_, ok := m.Fields[fieldId]
+	return ok
+}
+
+/*
+Returns the fields of an issue the current user is allowed to edit, with
+the same schema and allowed values details as CreateMeta.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/editmeta
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	meta, err := jira.EditMeta("FOO-12")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	if !meta.Editable("assignee") {
+		// gray out the assignee picker
+	}
+*/
+func (j *Jira) EditMeta(issueKey string) (meta *EditMeta, err error) {
+	return j.EditMetaCtx(context.Background(), issueKey)
+}
+
+// EditMetaCtx is like EditMeta but aborts the request when ctx is done.
+func (j *Jira) EditMetaCtx(ctx context.Context, issueKey string) (meta *EditMeta, err error) {
+	url := j.url(j.ApiPath, issue_url+"/%s"+editmeta_url, issueKey)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	meta = &EditMeta{}
+	err = json.Unmarshal(contents, meta)
+	return
+}
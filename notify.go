@@ -0,0 +1,97 @@
+package gojira
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	notify_url = "/notify"
+)
+
+// Recipients selects who receives an issue notification. Users are
+// identified by AccountId on Jira Cloud and by Name on Jira Server,
+// Groups by name.
+type Recipients struct {
+	Reporter bool
+	Assignee bool
+	Watchers bool
+	Voters   bool
+	Users    []*User
+	Groups   []string
+}
+
+func (r Recipients) empty() bool {
+	return !r.Reporter && !r.Assignee && !r.Watchers && !r.Voters && len(r.Users) == 0 && len(r.Groups) == 0
+}
+
+// payload builds the "to" object of the notify request
+func (r Recipients) payload() map[string]interface{} {
+	users := make([]map[string]string, 0, len(r.Users))
+	for _, user := range r.Users {
+		if user.AccountId != "" {
+			users = append(users, map[string]string{"accountId": user.AccountId})
+		} else {
+			users = append(users, map[string]string{"name": user.Name})
+		}
+	}
+
+	groups := make([]map[string]string, len(r.Groups))
+	for i, group := range r.Groups {
+		groups[i] = map[string]string{"name": group}
+	}
+
+	return map[string]interface{}{
+		"reporter": r.Reporter,
+		"assignee": r.Assignee,
+		"watchers": r.Watchers,
+		"voters":   r.Voters,
+		"users":    users,
+		"groups":   groups,
+	}
+}
+
+/*
+Emails a notification about an issue, without leaving a comment on it.
+Jira queues the mail and answers 204 No Content. Users who can't browse
+the issue are skipped.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/notify
+
+Parameters
+
+	issueKey string     The issue id or key
+	subject  string     The subject of the mail, defaults to the issue summary when empty
+	body     string     The text of the mail
+	to       Recipients Who receives the mail
+
+Usage
+
+	err := jira.Notify("FOO-12", "Release blocked", "FOO-12 blocks the 2.0 release", gojira.Recipients{
+		Assignee: true,
+		Watchers: true,
+		Groups:   []string{"release-managers"},
+	})
+*/
+func (j *Jira) Notify(issueKey string, subject, body string, to Recipients) (err error) {
+	return j.NotifyCtx(context.Background(), issueKey, subject, body, to)
+}
+
+// NotifyCtx is like Notify but aborts the request when ctx is done.
+func (j *Jira) NotifyCtx(ctx context.Context, issueKey string, subject, body string, to Recipients) (err error) {
+	if to.empty() {
+		return errors.New("at least one recipient is required to send a notification")
+	}
+
+	payload := map[string]interface{}{
+		"textBody": body,
+		"to":       to.payload(),
+	}
+	if subject != "" {
+		payload["subject"] = subject
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+notify_url, issueKey)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	return
+}
@@ -0,0 +1,291 @@
+package gojira
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	oauth_request_token_url = "/plugins/servlet/oauth/request-token"
+	oauth_authorize_url     = "/plugins/servlet/oauth/authorize"
+	oauth_access_token_url  = "/plugins/servlet/oauth/access-token"
+)
+
+/*
+OAuthConfig describes a consumer registered in Jira as an application link,
+requests being signed using RSA-SHA1 as required by Jira.
+
+The 3-legged dance goes as follow:
+
+	config := &gojira.OAuthConfig{
+		BaseUrl:     "http://example.com:8080/jira",
+		ConsumerKey: "my-consumer",
+		PrivateKey:  key, // see ParseOAuthPrivateKey
+	}
+
+	requestToken, err := config.RequestToken()
+	// let the user approve the access, then get the verifier code
+	fmt.Println(config.AuthorizeURL(requestToken))
+	accessToken, err := config.AccessToken(requestToken, verifier)
+
+	// no Auth, the transport signs every request
+	jira := gojira.NewJiraWithClient(config.BaseUrl, "/rest/api/2", "/activity", nil, config.Client(accessToken))
+*/
+type OAuthConfig struct {
+	// BaseUrl is the jira base url, the oauth servlet living below it
+	BaseUrl     string
+	ConsumerKey string
+	PrivateKey  *rsa.PrivateKey
+	// Callback is the url the user is redirected to once the access is
+	// approved, "oob" when empty so the verifier is displayed instead
+	Callback string
+}
+
+type OAuthToken struct {
+	Token  string
+	Secret string
+}
+
+// ParseOAuthPrivateKey decodes a PEM encoded PKCS#1 or PKCS#8 RSA private key.
+func ParseOAuthPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found in private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// RequestToken obtains a temporary token for the user to authorize.
+func (c *OAuthConfig) RequestToken() (*OAuthToken, error) {
+	callback := c.Callback
+	if callback == "" {
+		callback = "oob"
+	}
+
+	return c.exchangeToken(oauth_request_token_url, map[string]string{
+		"oauth_callback": callback,
+	})
+}
+
+// AuthorizeURL returns the page where the user approves the request token.
+func (c *OAuthConfig) AuthorizeURL(requestToken *OAuthToken) string {
+	return c.BaseUrl + oauth_authorize_url + "?oauth_token=" + url.QueryEscape(requestToken.Token)
+}
+
+// AccessToken exchanges an authorized request token and its verifier for
+// the access token used to sign api requests.
+func (c *OAuthConfig) AccessToken(requestToken *OAuthToken, verifier string) (*OAuthToken, error) {
+	return c.exchangeToken(oauth_access_token_url, map[string]string{
+		"oauth_token":    requestToken.Token,
+		"oauth_verifier": verifier,
+	})
+}
+
+// Client returns an http.Client signing its requests with token.
+func (c *OAuthConfig) Client(token *OAuthToken) *http.Client {
+	return &http.Client{
+		Timeout: DefaultTimeout,
+		Transport: &OAuthTransport{
+			Config: c,
+			Token:  token,
+			Base:   sharedTransport,
+		},
+	}
+}
+
+func (c *OAuthConfig) exchangeToken(path string, oauthParams map[string]string) (*OAuthToken, error) {
+	req, err := http.NewRequestWithContext(context.Background(), "POST", c.BaseUrl+path, nil)
+	if err != nil {
+		return nil, errors.New("Error while building jira request")
+	}
+
+	if err = c.sign(req, oauthParams); err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: DefaultTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !okStatus(resp.StatusCode) {
+		return nil, &ErrorResponse{
+			Messages:   []string{strings.TrimSpace(string(contents))},
+			Status:     resp.Status,
+			StatusCode: resp.StatusCode,
+			Method:     req.Method,
+			URL:        redactedUrl(req.URL),
+		}
+	}
+
+	values, err := url.ParseQuery(string(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	token := &OAuthToken{
+		Token:  values.Get("oauth_token"),
+		Secret: values.Get("oauth_token_secret"),
+	}
+	if token.Token == "" {
+		return nil, errors.New("jira did not return an oauth token")
+	}
+
+	return token, nil
+}
+
+// sign sets the OAuth Authorization header of req, oauthParams holding the
+// protocol parameters specific to the request (token, callback, verifier).
+func (c *OAuthConfig) sign(req *http.Request, oauthParams map[string]string) error {
+	if c.PrivateKey == nil {
+		return errors.New("oauth private key is required to sign requests")
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     c.ConsumerKey,
+		"oauth_nonce":            hex.EncodeToString(nonce),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	for key, value := range oauthParams {
+		params[key] = value
+	}
+
+	return c.signWith(req, params)
+}
+
+// signWith sets the Authorization header of req from the complete set of
+// protocol parameters, nonce and timestamp included.
+func (c *OAuthConfig) signWith(req *http.Request, params map[string]string) error {
+	// the signature covers the query parameters along with the oauth ones
+	pairs := make([]string, 0, len(params))
+	for key, value := range params {
+		pairs = append(pairs, oauthEscape(key)+"="+oauthEscape(value))
+	}
+	for key, values := range req.URL.Query() {
+		for _, value := range values {
+			pairs = append(pairs, oauthEscape(key)+"="+oauthEscape(value))
+		}
+	}
+	sort.Strings(pairs)
+
+	scheme := strings.ToLower(req.URL.Scheme)
+	host := strings.ToLower(req.URL.Host)
+	if (scheme == "http" && strings.HasSuffix(host, ":80")) || (scheme == "https" && strings.HasSuffix(host, ":443")) {
+		host = host[:strings.LastIndex(host, ":")]
+	}
+	baseUrl := scheme + "://" + host + req.URL.EscapedPath()
+	base := req.Method + "&" + oauthEscape(baseUrl) + "&" + oauthEscape(strings.Join(pairs, "&"))
+
+	hashed := sha1.Sum([]byte(base))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.PrivateKey, crypto.SHA1, hashed[:])
+	if err != nil {
+		return err
+	}
+	params["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var header bytes.Buffer
+	header.WriteString("OAuth ")
+	for i, key := range keys {
+		if i > 0 {
+			header.WriteString(", ")
+		}
+		header.WriteString(oauthEscape(key) + "=\"" + oauthEscape(params[key]) + "\"")
+	}
+	req.Header.Set("Authorization", header.String())
+
+	return nil
+}
+
+// oauthEscape percent encodes s as mandated by RFC 5849, leaving only
+// unreserved characters untouched.
+func oauthEscape(s string) string {
+	var buffer bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			buffer.WriteByte(c)
+		default:
+			buffer.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+		}
+	}
+
+	return buffer.String()
+}
+
+// OAuthTransport is an http.RoundTripper signing requests with an access
+// token, see OAuthConfig.
+type OAuthTransport struct {
+	Config *OAuthConfig
+	Token  *OAuthToken
+	// Base is the transport actually sending requests,
+	// http.DefaultTransport when nil
+	Base http.RoundTripper
+}
+
+func (t *OAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request it was given
+	signed := req.Clone(req.Context())
+	if err := t.Config.sign(signed, map[string]string{"oauth_token": t.Token.Token}); err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(signed)
+}
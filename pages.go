@@ -0,0 +1,68 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// a page of the paginated "values" lists returned by newer endpoints
+type pagedValues struct {
+	StartAt    int             `json:"startAt"`
+	MaxResults int             `json:"maxResults"`
+	Total      int             `json:"total"`
+	IsLast     bool            `json:"isLast"`
+	Values     json.RawMessage `json:"values"`
+}
+
+// decode the page values into v, leaving it untouched when there are none
+func (p *pagedValues) decode(v interface{}) error {
+	if len(p.Values) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(p.Values, v)
+}
+
+func (p *pagedValues) pagination() *Pagination {
+	pagination := &Pagination{
+		Total:      p.Total,
+		StartAt:    p.StartAt,
+		MaxResults: p.MaxResults,
+	}
+	pagination.Compute()
+
+	return pagination
+}
+
+// eachPage walks the pages of a "values" list, calling decode on each of
+// them until the last one. decode returns the number of values of the page.
+func (j *Jira) eachPage(ctx context.Context, url string, params Params, decode func(page *pagedValues) (int, error)) error {
+	if params == nil {
+		params = Params{}
+	}
+
+	for startAt := 0; ; {
+		params["startAt"] = strconv.Itoa(startAt)
+
+		contents, err := j.buildAndExecRequestCtx(ctx, "GET", url+"?"+params.Query())
+		if err != nil {
+			return err
+		}
+
+		page := pagedValues{}
+		if err = json.Unmarshal(contents, &page); err != nil {
+			return err
+		}
+
+		count, err := decode(&page)
+		if err != nil {
+			return err
+		}
+
+		startAt = page.StartAt + count
+		if page.IsLast || count == 0 || (page.Total > 0 && startAt >= page.Total) {
+			return nil
+		}
+	}
+}
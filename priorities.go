@@ -0,0 +1,94 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const (
+	priority_url   = "/priority"
+	resolution_url = "/resolution"
+)
+
+type Priority struct {
+	Self        string `json:"self"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IconUrl     string `json:"iconUrl"`
+	StatusColor string `json:"statusColor"`
+}
+
+type Resolution struct {
+	Self        string `json:"self"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+/*
+Returns every issue priority of the instance.
+
+	GET http://example.com:8080/jira/rest/api/2/priority
+
+Usage
+
+	priorities, err := jira.Priorities()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, priority := range priorities {
+		fmt.Println(priority.Id, priority.Name)
+	}
+*/
+func (j *Jira) Priorities() (priorities []*Priority, err error) {
+	return j.PrioritiesCtx(context.Background())
+}
+
+// PrioritiesCtx is like Priorities but aborts the request when ctx is done.
+func (j *Jira) PrioritiesCtx(ctx context.Context) (priorities []*Priority, err error) {
+	url := j.url(j.ApiPath, priority_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	priorities = []*Priority{}
+
+	err = json.Unmarshal(contents, &priorities)
+	return
+}
+
+/*
+Returns every issue resolution of the instance.
+
+	GET http://example.com:8080/jira/rest/api/2/resolution
+
+Usage
+
+	resolutions, err := jira.Resolutions()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, resolution := range resolutions {
+		fmt.Println(resolution.Id, resolution.Name)
+	}
+*/
+func (j *Jira) Resolutions() (resolutions []*Resolution, err error) {
+	return j.ResolutionsCtx(context.Background())
+}
+
+// ResolutionsCtx is like Resolutions but aborts the request when ctx is
+// done.
+func (j *Jira) ResolutionsCtx(ctx context.Context) (resolutions []*Resolution, err error) {
+	url := j.url(j.ApiPath, resolution_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	resolutions = []*Resolution{}
+
+	err = json.Unmarshal(contents, &resolutions)
+	return
+}
@@ -0,0 +1,170 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	project_url        = "/project"
+	project_search_url = "/project/search"
+	components_url     = "/components"
+)
+
+/*
+Returns all the projects visible to the current user, along with their lead.
+
+	GET http://example.com:8080/jira/rest/api/2/project?expand=description,lead
+
+Usage
+
+	projects, err := jira.Projects()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, project := range projects {
+		fmt.Println(project.Key, project.Name, project.Lead.DisplayName)
+	}
+*/
+func (j *Jira) Projects() (projects []*JiraProject, err error) {
+	return j.ProjectsCtx(context.Background())
+}
+
+// ProjectsCtx is like Projects but aborts the request when ctx is done.
+func (j *Jira) ProjectsCtx(ctx context.Context) (projects []*JiraProject, err error) {
+	url := j.url(j.ApiPath, project_url) + "?expand=description,lead"
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	projects = []*JiraProject{}
+	err = json.Unmarshal(contents, &projects)
+	return
+}
+
+/*
+Returns a single project.
+
+	GET http://example.com:8080/jira/rest/api/2/project/{projectIdOrKey}
+
+Parameters
+
+	keyOrId string The project id or key
+
+Usage
+
+	project, err := jira.Project("FOO")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(project.Name, project.AvatarUrls["48x48"])
+*/
+func (j *Jira) Project(keyOrId string) (project *JiraProject, err error) {
+	return j.ProjectCtx(context.Background(), keyOrId)
+}
+
+// ProjectCtx is like Project but aborts the request when ctx is done.
+func (j *Jira) ProjectCtx(ctx context.Context, keyOrId string) (project *JiraProject, err error) {
+	url := j.url(j.ApiPath, project_url+"/%s", keyOrId)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	project = &JiraProject{}
+	err = json.Unmarshal(contents, project)
+	return
+}
+
+/*
+Returns a page of the projects visible to the current user. This is the
+paginated variant Jira Cloud offers instead of listing every project.
+
+	GET http://example.com:8080/jira/rest/api/2/project/search
+
+Parameters
+
+	query      string Matched against the project key and name, all projects when empty
+	startAt    int    The index of the first project to return (0-based)
+	maxResults int    The maximum number of projects to return
+
+Usage
+
+	projects, pagination, err := jira.SearchProjects("", 0, 50)
+*/
+func (j *Jira) SearchProjects(query string, startAt int, maxResults int) (projects []*JiraProject, pagination *Pagination, err error) {
+	return j.SearchProjectsCtx(context.Background(), query, startAt, maxResults)
+}
+
+// SearchProjectsCtx is like SearchProjects but aborts the request when ctx
+// is done.
+func (j *Jira) SearchProjectsCtx(ctx context.Context, query string, startAt int, maxResults int) (projects []*JiraProject, pagination *Pagination, err error) {
+	params := Params{
+		"expand":     "description,lead",
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+	if query != "" {
+		params["query"] = query
+	}
+
+	url := j.url(j.ApiPath, project_search_url) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	page := pagedValues{}
+	err = json.Unmarshal(contents, &page)
+	if err != nil {
+		return
+	}
+
+	projects = []*JiraProject{}
+	err = page.decode(&projects)
+	if err != nil {
+		return
+	}
+
+	pagination = page.pagination()
+	return
+}
+
+/*
+Returns the components of a project.
+
+	GET http://example.com:8080/jira/rest/api/2/project/{projectIdOrKey}/components
+
+Parameters
+
+	projectKey string The project id or key
+
+Usage
+
+	components, err := jira.ProjectComponents("FOO")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, component := range components {
+		fmt.Println(component.Id, component.Name)
+	}
+*/
+func (j *Jira) ProjectComponents(projectKey string) (components []*Component, err error) {
+	return j.ProjectComponentsCtx(context.Background(), projectKey)
+}
+
+// ProjectComponentsCtx is like ProjectComponents but aborts the request when
+// ctx is done.
+func (j *Jira) ProjectComponentsCtx(ctx context.Context, projectKey string) (components []*Component, err error) {
+	url := j.url(j.ApiPath, project_url+"/%s"+components_url, projectKey)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	components = []*Component{}
+	err = json.Unmarshal(contents, &components)
+	return
+}
@@ -0,0 +1,122 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	properties_url = "/properties"
+)
+
+// ErrPropertyNotFound is returned by IssueProperty when jira answers 404,
+// which it does when the property isn't set as well as for unknown issues.
+var ErrPropertyNotFound = errors.New("issue property is not set or the issue does not exist")
+
+type entityProperty struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (j *Jira) issuePropertyUrl(issueKey, propKey string) string {
+	return j.url(j.ApiPath, issue_url+"/%s"+properties_url+"/%s", issueKey, propKey)
+}
+
+/*
+Returns the JSON value stored under an issue property.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/properties/{propertyKey}
+
+Parameters
+
+	issueKey string The issue id or key
+	propKey  string The key of the property
+
+Usage
+
+	value, err := jira.IssueProperty("FOO-12", "com.example.sync")
+	if errors.Is(err, gojira.ErrPropertyNotFound) {
+		fmt.Println("never synced")
+	}
+	state := SyncState{}
+	err = json.Unmarshal(value, &state)
+*/
+func (j *Jira) IssueProperty(issueKey, propKey string) (value json.RawMessage, err error) {
+	return j.IssuePropertyCtx(context.Background(), issueKey, propKey)
+}
+
+// IssuePropertyCtx is like IssueProperty but aborts the request when ctx is
+// done.
+func (j *Jira) IssuePropertyCtx(ctx context.Context, issueKey, propKey string) (value json.RawMessage, err error) {
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", j.issuePropertyUrl(issueKey, propKey))
+	if err != nil {
+		err = statusError(err, ErrPropertyNotFound, http.StatusNotFound)
+		return
+	}
+
+	property := entityProperty{}
+	err = json.Unmarshal(contents, &property)
+	if err != nil {
+		return
+	}
+
+	return property.Value, nil
+}
+
+/*
+Stores a value, encoded to JSON, under an issue property, replacing the
+previous one.
+
+	PUT http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/properties/{propertyKey}
+
+Parameters
+
+	issueKey string      The issue id or key
+	propKey  string      The key of the property
+	value    interface{} The value of the property, at most 32KB once encoded
+
+Usage
+
+	err := jira.SetIssueProperty("FOO-12", "com.example.sync", SyncState{Revision: 3})
+*/
+func (j *Jira) SetIssueProperty(issueKey, propKey string, value interface{}) (err error) {
+	return j.SetIssuePropertyCtx(context.Background(), issueKey, propKey, value)
+}
+
+// SetIssuePropertyCtx is like SetIssueProperty but aborts the request when
+// ctx is done.
+func (j *Jira) SetIssuePropertyCtx(ctx context.Context, issueKey, propKey string, value interface{}) (err error) {
+	if propKey == "" {
+		return errors.New("property key is required to set an issue property")
+	}
+
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "PUT", j.issuePropertyUrl(issueKey, propKey), value)
+	return
+}
+
+/*
+Removes a property from an issue.
+
+	DELETE http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/properties/{propertyKey}
+
+Parameters
+
+	issueKey string The issue id or key
+	propKey  string The key of the property
+
+Usage
+
+	err := jira.DeleteIssueProperty("FOO-12", "com.example.sync")
+*/
+func (j *Jira) DeleteIssueProperty(issueKey, propKey string) (err error) {
+	return j.DeleteIssuePropertyCtx(context.Background(), issueKey, propKey)
+}
+
+// DeleteIssuePropertyCtx is like DeleteIssueProperty but aborts the request
+// when ctx is done.
+func (j *Jira) DeleteIssuePropertyCtx(ctx context.Context, issueKey, propKey string) (err error) {
+	_, err = j.buildAndExecRequestCtx(ctx, "DELETE", j.issuePropertyUrl(issueKey, propKey))
+	return
+}
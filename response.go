@@ -0,0 +1,149 @@
+package gojira
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResponseMeta describes the last response received from jira, exposing
+// the rate limit headers so callers can throttle before being limited.
+// The rate limit counters are -1 when jira did not send them.
+type ResponseMeta struct {
+	StatusCode         int
+	Header             http.Header
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RetryAfter         time.Duration
+}
+
+func newResponseMeta(resp *http.Response) *ResponseMeta {
+	// the header is copied as decompress then edits the response one
+	meta := &ResponseMeta{
+		StatusCode:         resp.StatusCode,
+		Header:             resp.Header.Clone(),
+		RateLimitLimit:     headerInt(resp.Header, "X-RateLimit-Limit"),
+		RateLimitRemaining: headerInt(resp.Header, "X-RateLimit-Remaining"),
+	}
+	meta.RetryAfter, _ = retryAfter(resp)
+
+	return meta
+}
+
+func headerInt(header http.Header, name string) int {
+	value, err := strconv.Atoi(header.Get(name))
+	if err != nil {
+		return -1
+	}
+
+	return value
+}
+
+func (j *Jira) recordResponse(resp *http.Response) {
+	meta := newResponseMeta(resp)
+
+	j.lastResponseMu.Lock()
+	j.lastResponse = meta
+	j.lastResponseMu.Unlock()
+}
+
+/*
+Returns the metadata of the last response received by the client, nil
+before the first request. When the client is shared between goroutines
+it is the last response received by any of them.
+
+Usage
+
+	issues, err := jira.Search(jql, 0, 50, nil, nil)
+	if meta := jira.LastResponse(); meta != nil && meta.RateLimitRemaining == 0 {
+		time.Sleep(meta.RetryAfter)
+	}
+*/
+func (j *Jira) LastResponse() *ResponseMeta {
+	j.lastResponseMu.Lock()
+	defer j.lastResponseMu.Unlock()
+
+	return j.lastResponse
+}
+
+// ErrResponseTooLarge is returned when a response body exceeds Jira.MaxResponseBytes.
+var ErrResponseTooLarge = errors.New("jira response exceeds the maximum allowed size")
+
+// limitBody guards body against exceeding MaxResponseBytes
+func (j *Jira) limitBody(body io.Reader) io.Reader {
+	if j.MaxResponseBytes <= 0 {
+		return body
+	}
+
+	return &maxBytesReader{reader: body, remaining: j.MaxResponseBytes}
+}
+
+// maxBytesReader fails with ErrResponseTooLarge once more than remaining
+// bytes were read, unlike io.LimitReader which silently stops at the limit.
+type maxBytesReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *maxBytesReader) Read(p []byte) (n int, err error) {
+	if r.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+
+	// read one byte past the limit to tell a body of exactly the
+	// limit from a larger one
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+
+	n, err = r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n + int(r.remaining), ErrResponseTooLarge
+	}
+
+	return
+}
+
+// gzipBody closes both the gzip reader and the underlying response body
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
+// decompress replaces a gzip encoded response body by its decompressed
+// content. The transport only does so by itself for requests it added
+// the Accept-Encoding header to, not when RequestGzip set it or when a
+// custom transport is used.
+func decompress(resp *http.Response) error {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err == io.EOF {
+		// empty body, e.g. 204 No Content
+		return nil
+	}
+	if err != nil {
+		resp.Body.Close()
+		return fmt.Errorf("decompressing response body: %w", err)
+	}
+
+	resp.Body = &gzipBody{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
@@ -0,0 +1,88 @@
+package gojira
+
+import (
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// gzipHandler answers with a gzip encoded issue when the request accepts it
+func gzipHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.Write([]byte(`{"id":"10002","key":"FOO-12"}`))
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		writer.Write([]byte(`{"id":"10002","key":"FOO-12"}`))
+		if err := writer.Close(); err != nil {
+			t.Errorf("compressing response: %v", err)
+		}
+	}
+}
+
+func TestRequestGzip(t *testing.T) {
+	for _, requestGzip := range []bool{true, false} {
+		jira := newTestJira(t, gzipHandler(t))
+		jira.RequestGzip = requestGzip
+
+		issue, err := jira.Issue("FOO-12", nil)
+		if err != nil {
+			t.Errorf("RequestGzip %v: %v", requestGzip, err)
+			continue
+		}
+		if issue.Key != "FOO-12" {
+			t.Errorf("RequestGzip %v: Key = %q, want FOO-12", requestGzip, issue.Key)
+		}
+	}
+}
+
+func TestRequestGzipEmptyBody(t *testing.T) {
+	for _, requestGzip := range []bool{true, false} {
+		jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusNoContent)
+		})
+		jira.RequestGzip = requestGzip
+
+		if err := jira.DeleteIssue("FOO-12", false); err != nil {
+			t.Errorf("RequestGzip %v: %v", requestGzip, err)
+		}
+	}
+}
+
+func TestMaxResponseBytesOnErrors(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(strings.Repeat("<html>proxy error</html>", 1000)))
+	})
+	jira.MaxResponseBytes = 64
+
+	_, err := jira.Issue("FOO-12", nil)
+
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) {
+		t.Fatalf("expected an *ErrorResponse, got %v", err)
+	}
+	if errResponse.StatusCode != http.StatusBadGateway {
+		t.Errorf("StatusCode = %d, want 502", errResponse.StatusCode)
+	}
+}
+
+func TestLastResponseHeaderWithGzip(t *testing.T) {
+	jira := newTestJira(t, gzipHandler(t))
+	jira.RequestGzip = true
+
+	if _, err := jira.Issue("FOO-12", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := jira.LastResponse().Header.Get("Content-Encoding"); got != "gzip" {
+		t.Errorf("Content-Encoding = %q, want the header as received", got)
+	}
+}
@@ -0,0 +1,131 @@
+package gojira
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryTooManyRequests(t *testing.T) {
+	calls := 0
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	jira.MaxRetries = 3
+
+	if err := jira.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("got %d calls, want 3", calls)
+	}
+}
+
+func TestRetryServiceUnavailableBackoff(t *testing.T) {
+	calls := 0
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			// no Retry-After, the backoff applies
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	jira.MaxRetries = 1
+
+	start := time.Now()
+	if err := jira.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("got %d calls, want 2", calls)
+	}
+	if elapsed := time.Since(start); elapsed < retryBaseDelay {
+		t.Errorf("retried after %s, want at least %s", elapsed, retryBaseDelay)
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	jira.MaxRetries = 2
+
+	err := jira.Ping()
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) || errResponse.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected the last 503 to be reported, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("got %d calls, want the request and 2 retries", calls)
+	}
+}
+
+func TestRetrySkipsPost(t *testing.T) {
+	calls := 0
+	bodies := []string{}
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":"10000"}`))
+	})
+	jira.MaxRetries = 3
+
+	if _, err := jira.AddComment("FOO-12", "Deployed"); err == nil {
+		t.Error("expected the 503 to be reported")
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, a POST must not be retried", calls)
+	}
+
+	calls, bodies = 0, nil
+	jira.RetryNonIdempotent = true
+	if _, err := jira.AddComment("FOO-12", "Deployed"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || bodies[0] != bodies[1] {
+		t.Errorf("expected the POST to be sent again with its body, got %q", bodies)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	if delay := retryDelay(resp, 0); delay != retryBaseDelay {
+		t.Errorf("first delay = %s, want %s", delay, retryBaseDelay)
+	}
+	if delay := retryDelay(resp, 2); delay != 4*retryBaseDelay {
+		t.Errorf("third delay = %s, want %s", delay, 4*retryBaseDelay)
+	}
+	if delay := retryDelay(resp, 20); delay != retryMaxDelay {
+		t.Errorf("delay = %s, want it capped at %s", delay, retryMaxDelay)
+	}
+
+	resp.Header.Set("Retry-After", "7")
+	if delay := retryDelay(resp, 20); delay != 7*time.Second {
+		t.Errorf("delay = %s, want the 7s of Retry-After", delay)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	if delay := retryDelay(resp, 0); delay != 0 {
+		t.Errorf("delay = %s, want no wait for a past date", delay)
+	}
+}
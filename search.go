@@ -0,0 +1,442 @@
+package gojira
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	search_url            = "/search"
+	defaultSearchPageSize = 50
+)
+
+// defaultSearchFields are returned by searches not asking for specific
+// fields, rather than every navigable field. The key is always returned.
+var defaultSearchFields = []string{"summary", "status"}
+
+// searchFields returns the fields to request, fields being ids, "*all",
+// "*navigable" or exclusions such as "-description"
+func searchFields(fields []string) []string {
+	if len(fields) == 0 {
+		return defaultSearchFields
+	}
+
+	return fields
+}
+
+func (j *Jira) searchPageSize() int {
+	if j.SearchPageSize > 0 {
+		return j.SearchPageSize
+	}
+
+	return defaultSearchPageSize
+}
+
+// fetch a single page of issues matching jql
+func (j *Jira) searchPage(ctx context.Context, jql string, startAt int, maxResults int, fields []string, expand []string) (issues IssueList, err error) {
+	params := Params{
+		"jql":        jql,
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+	params["fields"] = strings.Join(searchFields(fields), ",")
+	if len(expand) > 0 {
+		params["expand"] = strings.Join(expand, ",")
+	}
+
+	url := j.url(j.ApiPath, search_url) + "?" + params.Query()
+	req, err := j.newRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return
+	}
+
+	// search pages can be large, decode them as they arrive
+	err = j.decodeRequest(req, &issues)
+	if err != nil {
+		return
+	}
+
+	err = issues.prepare()
+	return
+}
+
+/*
+Searches for issues using JQL and returns a single page of results.
+Timestamps Jira sends in an unexpected format are left to the zero time
+and reported through the returned error, the issues being still usable.
+
+	GET http://example.com:8080/jira/rest/api/2/search
+
+Parameters
+
+	jql        string   The JQL query, e.g. "project = FOO AND status = Open ORDER BY created DESC"
+	startAt    int      The index of the first issue to return (0-based)
+	maxResults int      The maximum number of issues to return
+	fields     []string The fields to return for each issue, summary and status when empty.
+	                    "*all", "*navigable" and exclusions such as "-description" are accepted.
+	expand     []string The entities to expand for each issue, e.g. "changelog", "renderedFields"
+
+Usage
+
+	issues, err := jira.Search("project = FOO ORDER BY created DESC", 0, 20, []string{"summary", "created"}, nil)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(issues.Pagination.PageCount)
+*/
+func (j *Jira) Search(jql string, startAt int, maxResults int, fields []string, expand []string) (IssueList, error) {
+	return j.SearchCtx(context.Background(), jql, startAt, maxResults, fields, expand)
+}
+
+// SearchCtx is like Search but aborts the request when ctx is done.
+func (j *Jira) SearchCtx(ctx context.Context, jql string, startAt int, maxResults int, fields []string, expand []string) (IssueList, error) {
+	return j.searchPage(ctx, jql, startAt, maxResults, fields, expand)
+}
+
+var (
+	// string literals of a query, which may contain anything
+	jqlStringPattern  = regexp.MustCompile(`"(\\.|[^"\\])*"|'(\\.|[^'\\])*'`)
+	jqlOrderByPattern = regexp.MustCompile(`(?i)\border\s+by\b`)
+)
+
+// orderBy appends an ORDER BY clause to jql, sortField being quoted unless
+// it is a plain field name so it cannot alter the query
+func orderBy(jql, sortField string, ascending bool) (string, error) {
+	if strings.TrimSpace(sortField) == "" {
+		return "", errors.New("sort field is required to sort a search")
+	}
+	if jqlOrderByPattern.MatchString(jqlStringPattern.ReplaceAllString(jql, `""`)) {
+		return "", errors.New("query is already sorted with ORDER BY")
+	}
+
+	direction := "DESC"
+	if ascending {
+		direction = "ASC"
+	}
+
+	return strings.TrimSpace(jql) + " ORDER BY " + jqlField(sortField) + " " + direction, nil
+}
+
+/*
+Same as Search, sorting the issues on a field. The ORDER BY clause is
+appended to the query, which must not have one, and the applied sort is
+recorded in the Sort of the returned list. Field names which are not
+plain identifiers, such as "Story Points", are quoted.
+
+	GET http://example.com:8080/jira/rest/api/2/search
+
+Parameters
+
+	jql        string   The JQL query, without ORDER BY
+	sortField  string   The field to sort on, e.g. "created" or "priority"
+	ascending  bool     Whether to sort in ascending order
+	startAt    int      The index of the first issue to return (0-based)
+	maxResults int      The maximum number of issues to return
+	fields     []string The fields to return for each issue, see Search
+	expand     []string The entities to expand for each issue
+
+Usage
+
+	issues, err := jira.SearchSorted("project = FOO", "updated", false, 0, 20, nil, nil)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(issues.Sort.Field, issues.Sort.Ascending)
+*/
+func (j *Jira) SearchSorted(jql string, sortField string, ascending bool, startAt int, maxResults int, fields []string, expand []string) (issues IssueList, err error) {
+	return j.SearchSortedCtx(context.Background(), jql, sortField, ascending, startAt, maxResults, fields, expand)
+}
+
+// SearchSortedCtx is like SearchSorted but aborts the request when ctx is
+// done.
+func (j *Jira) SearchSortedCtx(ctx context.Context, jql string, sortField string, ascending bool, startAt int, maxResults int, fields []string, expand []string) (issues IssueList, err error) {
+	sorted, err := orderBy(jql, sortField, ascending)
+	if err != nil {
+		return
+	}
+
+	issues, err = j.searchPage(ctx, sorted, startAt, maxResults, fields, expand)
+	issues.Sort = &SearchSort{Field: sortField, Ascending: ascending}
+	return
+}
+
+// body of POST /search requests
+type searchRequest struct {
+	Jql        string   `json:"jql"`
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Fields     []string `json:"fields,omitempty"`
+	Expand     []string `json:"expand,omitempty"`
+	// "strict" by default, "warn" turns errors such as unknown issue
+	// keys into warnings
+	ValidateQuery string `json:"validateQuery,omitempty"`
+}
+
+/*
+Same as Search, but sends the query in a POST body rather than in the url.
+Use it for long JQL, like "key IN (...)" with hundreds of keys, which would
+otherwise be rejected with 414 URI Too Long.
+
+	POST http://example.com:8080/jira/rest/api/2/search
+
+Usage
+
+	jql := "key IN (" + strings.Join(keys, ",") + ")"
+	issues, err := jira.SearchPost(jql, 0, len(keys), []string{"summary"}, nil)
+*/
+func (j *Jira) SearchPost(jql string, startAt int, maxResults int, fields []string, expand []string) (IssueList, error) {
+	return j.SearchPostCtx(context.Background(), jql, startAt, maxResults, fields, expand)
+}
+
+// SearchPostCtx is like SearchPost but aborts the request when ctx is done.
+func (j *Jira) SearchPostCtx(ctx context.Context, jql string, startAt int, maxResults int, fields []string, expand []string) (IssueList, error) {
+	return j.searchPostPage(ctx, &searchRequest{
+		Jql:        jql,
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Fields:     fields,
+		Expand:     expand,
+	})
+}
+
+func (j *Jira) searchPostPage(ctx context.Context, payload *searchRequest) (issues IssueList, err error) {
+	payload.Fields = searchFields(payload.Fields)
+
+	url := j.url(j.ApiPath, search_url)
+	req, err := j.newRequest(ctx, "POST", url, payload)
+	if err != nil {
+		return
+	}
+
+	err = j.decodeRequest(req, &issues)
+	if err != nil {
+		return
+	}
+
+	err = issues.prepare()
+	return
+}
+
+/*
+Returns every issue matching a JQL query, walking all the result pages
+SearchPageSize issues at a time. It stops at the first failing page,
+returning the error alongside the issues fetched so far. Unexpected
+timestamps do not stop the walk, the first one being reported once all
+the pages are fetched.
+
+	GET http://example.com:8080/jira/rest/api/2/search
+
+Parameters
+
+	jql    string   The JQL query
+	fields []string The fields to return for each issue, summary and status when empty
+
+Usage
+
+	jira.SearchPageSize = 100
+	issues, err := jira.SearchAll("project = FOO AND status = Open", []string{"summary", "status"})
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(len(issues))
+*/
+func (j *Jira) SearchAll(jql string, fields []string) (issues []*Issue, err error) {
+	return j.SearchAllCtx(context.Background(), jql, fields)
+}
+
+// SearchAllCtx is like SearchAll but stops walking the pages when ctx is done.
+func (j *Jira) SearchAllCtx(ctx context.Context, jql string, fields []string) (issues []*Issue, err error) {
+	return j.searchAll(ctx, jql, fields, j.searchPageSize())
+}
+
+func (j *Jira) searchAll(ctx context.Context, jql string, fields []string, pageSize int) (issues []*Issue, err error) {
+	var timeErr error
+	for startAt := 0; ; {
+		page, err := j.searchPage(ctx, jql, startAt, pageSize, fields, nil)
+		var parseErr *TimeParseError
+		if errors.As(err, &parseErr) {
+			if timeErr == nil {
+				timeErr = err
+			}
+		} else if err != nil {
+			return issues, err
+		}
+
+		issues = append(issues, page.Issues...)
+
+		// jira may cap maxResults below the requested page size,
+		// so move forward by what was actually returned
+		startAt = page.StartAt + len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issues, timeErr
+		}
+	}
+}
+
+// maximum number of keys looked up by a single IssuesByKeys search
+const issuesByKeysChunkSize = 100
+
+/*
+Fetches many issues by key with as few POST searches as possible, rather
+than one request per issue. Issues are returned in the order of keys, the
+keys which were not found, or are not visible to the user, being returned
+as missing. Issues moved to another key since are reported missing too, as
+jira returns them under their new key.
+
+	POST http://example.com:8080/jira/rest/api/2/search
+
+Parameters
+
+	keys   []string The keys of the issues
+	fields []string The fields to return for each issue, summary and status when empty
+
+Usage
+
+	issues, missing, err := jira.IssuesByKeys([]string{"FOO-1", "FOO-2", "BAR-7"}, []string{"summary", "status"})
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(len(issues), "found, missing:", missing)
+*/
+func (j *Jira) IssuesByKeys(keys []string, fields []string) (issues []*Issue, missing []string, err error) {
+	return j.IssuesByKeysCtx(context.Background(), keys, fields)
+}
+
+// IssuesByKeysCtx is like IssuesByKeys but aborts the searches when ctx is done.
+func (j *Jira) IssuesByKeysCtx(ctx context.Context, keys []string, fields []string) (issues []*Issue, missing []string, err error) {
+	found := make(map[string]*Issue, len(keys))
+
+	var timeErr error
+	for start := 0; start < len(keys); start += issuesByKeysChunkSize {
+		end := start + issuesByKeysChunkSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		chunk := keys[start:end]
+
+		payload := &searchRequest{
+			Jql:           NewJQL().In("key", chunk...).String(),
+			MaxResults:    len(chunk),
+			Fields:        fields,
+			ValidateQuery: "warn",
+		}
+		// jira may cap the page size below the chunk size
+		for {
+			page, err := j.searchPostPage(ctx, payload)
+			var parseErr *TimeParseError
+			if errors.As(err, &parseErr) {
+				if timeErr == nil {
+					timeErr = err
+				}
+			} else if err != nil {
+				return nil, nil, err
+			}
+
+			for _, issue := range page.Issues {
+				found[strings.ToUpper(issue.Key)] = issue
+			}
+
+			payload.StartAt = page.StartAt + len(page.Issues)
+			if len(page.Issues) == 0 || payload.StartAt >= page.Total {
+				break
+			}
+		}
+	}
+
+	issues = make([]*Issue, 0, len(found))
+	missing = []string{}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		// jira keys are case insensitive and always returned upper case
+		normalized := strings.ToUpper(key)
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+
+		if issue, ok := found[normalized]; ok {
+			issues = append(issues, issue)
+		} else {
+			missing = append(missing, key)
+		}
+	}
+
+	return issues, missing, timeErr
+}
+
+// layout of dates in JQL, in the time zone of the user
+const jqlDateLayout = "2006/01/02 15:04"
+
+/*
+Returns every issue updated since a given time, oldest update first, for
+incremental syncs. JQL dates have no seconds and are read in the time
+zone of the user's profile, which is fetched to convert since. since is
+rounded down to the minute, so issues updated during that minute are
+returned again by the next sync using the last UpdatedAt seen: callers
+are responsible for deduplicating issues by key and UpdatedAt.
+
+	GET http://example.com:8080/jira/rest/api/2/myself
+	GET http://example.com:8080/jira/rest/api/2/search
+
+Parameters
+
+	since    time.Time The time to look for updates from, inclusive
+	extraJQL string    A query restricting the issues, e.g. "project = FOO", may be empty
+	pageSize int       The number of issues requested per page, SearchPageSize when zero
+
+Usage
+
+	issues, err := jira.IssuesUpdatedSince(lastSync, "project = FOO", 100)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, issue := range issues {
+		fmt.Println(issue.Key, issue.UpdatedAt)
+	}
+*/
+func (j *Jira) IssuesUpdatedSince(since time.Time, extraJQL string, pageSize int) (issues []*Issue, err error) {
+	return j.IssuesUpdatedSinceCtx(context.Background(), since, extraJQL, pageSize)
+}
+
+// IssuesUpdatedSinceCtx is like IssuesUpdatedSince but aborts the requests
+// when ctx is done.
+func (j *Jira) IssuesUpdatedSinceCtx(ctx context.Context, since time.Time, extraJQL string, pageSize int) (issues []*Issue, err error) {
+	if pageSize <= 0 {
+		pageSize = j.searchPageSize()
+	}
+
+	location, err := j.userLocation(ctx)
+	if err != nil {
+		return
+	}
+
+	jql := "updated >= " + QuoteJQL(since.In(location).Format(jqlDateLayout))
+	if strings.TrimSpace(extraJQL) != "" {
+		jql = "(" + extraJQL + ") AND " + jql
+	}
+	jql, err = orderBy(jql, "updated", true)
+	if err != nil {
+		return
+	}
+
+	return j.searchAll(ctx, jql, []string{"*navigable"}, pageSize)
+}
+
+// userLocation returns the time zone JQL dates are read in, UTC when the
+// user's one is unknown
+func (j *Jira) userLocation(ctx context.Context) (*time.Location, error) {
+	me, err := j.MyselfCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if location, err := time.LoadLocation(me.TimeZone); err == nil && me.TimeZone != "" {
+		return location, nil
+	}
+
+	return time.UTC, nil
+}
@@ -0,0 +1,114 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSearchAllCtxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		startAt := r.URL.Query().Get("startAt")
+		if startAt != "0" {
+			// the caller gives up while the second page is being fetched
+			cancel()
+			<-r.Context().Done()
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"startAt":%s,"maxResults":1,"total":3,"issues":[{"id":"1","key":"FOO-1"}]}`, startAt)
+	})
+	jira.SearchPageSize = 1
+
+	issues, err := jira.SearchAllCtx(ctx, "project = FOO", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("got %d issues, want the one of the first page", len(issues))
+	}
+}
+
+func TestIssuesByKeysOrderAndMissing(t *testing.T) {
+	// jira answers in its own order, two issues per page, without the
+	// keys it does not know
+	known := []string{"FOO-1", "FOO-2", "FOO-3"}
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload searchRequest
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+			return
+		}
+		if !strings.HasPrefix(payload.Jql, "key IN (") {
+			t.Errorf("unexpected jql %s", payload.Jql)
+		}
+
+		issues := []string{}
+		for i := payload.StartAt; i < payload.StartAt+2 && i < len(known); i++ {
+			issues = append(issues, fmt.Sprintf(`{"id":"%d","key":"%s"}`, i+1, known[i]))
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":2,"total":%d,"issues":[%s]}`, payload.StartAt, len(known), strings.Join(issues, ","))
+	})
+
+	issues, missing, err := jira.IssuesByKeys([]string{"FOO-3", "BAR-7", "foo-1", "FOO-2", "FOO-3"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []string{}
+	for _, issue := range issues {
+		keys = append(keys, issue.Key)
+	}
+	if !reflect.DeepEqual(keys, []string{"FOO-3", "FOO-1", "FOO-2"}) {
+		t.Errorf("expected the issues in the order of the keys, got %v", keys)
+	}
+	if !reflect.DeepEqual(missing, []string{"BAR-7"}) {
+		t.Errorf("expected BAR-7 to be missing, got %v", missing)
+	}
+}
+
+func TestSearchSortedQuotesField(t *testing.T) {
+	var jql string
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		jql = r.URL.Query().Get("jql")
+		w.Write([]byte(`{"startAt":0,"maxResults":20,"total":0,"issues":[]}`))
+	})
+
+	tests := []struct {
+		field    string
+		expected string
+	}{
+		{"created", `project = FOO ORDER BY created ASC`},
+		{"cf[10010]", `project = FOO ORDER BY cf[10010] ASC`},
+		{"Story Points", `project = FOO ORDER BY "Story Points" ASC`},
+		// an attempt to close the clause and widen the query stays a field name
+		{`key" ASC, project = BAR OR "x`, `project = FOO ORDER BY "key\" ASC, project = BAR OR \"x" ASC`},
+		{"created, priority", `project = FOO ORDER BY "created, priority" ASC`},
+	}
+	for _, test := range tests {
+		issues, err := jira.SearchSorted("project = FOO", test.field, true, 0, 20, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if jql != test.expected {
+			t.Errorf("sorting on %q sent %s, want %s", test.field, jql, test.expected)
+		}
+		if issues.Sort == nil || issues.Sort.Field != test.field || !issues.Sort.Ascending {
+			t.Errorf("unexpected sort %+v", issues.Sort)
+		}
+	}
+
+	if _, err := jira.SearchSorted(`summary ~ "order by" ORDER BY key`, "created", true, 0, 20, nil, nil); err == nil {
+		t.Error("expected a query already sorted to be rejected")
+	}
+	if _, err := jira.SearchSorted(`summary ~ "order by"`, "created", true, 0, 20, nil, nil); err != nil {
+		t.Errorf("expected ORDER BY within a string to be ignored, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	server_info_url = "/serverInfo"
+	myself_url      = "/myself"
+)
+
+const (
+	DeploymentCloud  = "Cloud"
+	DeploymentServer = "Server"
+)
+
+type ServerInfo struct {
+	BaseUrl        string    `json:"baseUrl"`
+	Version        string    `json:"version"`
+	VersionNumbers []int     `json:"versionNumbers"`
+	DeploymentType string    `json:"deploymentType"` // "Cloud" or "Server"
+	BuildNumber    int       `json:"buildNumber"`
+	BuildDate      string    `json:"buildDate"`
+	ServerTime     string    `json:"serverTime"`
+	ServerTimeAt   time.Time `json:"-"`
+	ScmInfo        string    `json:"scmInfo"`
+	ServerTitle    string    `json:"serverTitle"`
+}
+
+/*
+Returns the version, build and deployment type of the instance along with
+its current time.
+
+	GET http://example.com:8080/jira/rest/api/2/serverInfo
+
+Usage
+
+	info, err := jira.ServerInfo()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(info.Version, info.DeploymentType, info.ServerTimeAt)
+*/
+func (j *Jira) ServerInfo() (info *ServerInfo, err error) {
+	return j.ServerInfoCtx(context.Background())
+}
+
+// ServerInfoCtx is like ServerInfo but aborts the request when ctx is done.
+func (j *Jira) ServerInfoCtx(ctx context.Context) (info *ServerInfo, err error) {
+	url := j.url(j.ApiPath, server_info_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	info = &ServerInfo{}
+	err = json.Unmarshal(contents, info)
+	if err != nil {
+		return
+	}
+
+	info.ServerTimeAt, err = parseJiraTime(info.ServerTime)
+	if err != nil {
+		err = &TimeParseError{Field: "server time", Value: info.ServerTime}
+	}
+
+	return
+}
+
+/*
+Checks jira can be reached and accepts the credentials of the client,
+returning nil when it does. Meant to fail fast before batch jobs.
+
+	GET http://example.com:8080/jira/rest/api/2/myself
+
+Usage
+
+	if err := jira.Ping(); err != nil {
+		log.Fatalf("cannot reach jira: %s", err)
+	}
+*/
+func (j *Jira) Ping() (err error) {
+	return j.PingCtx(context.Background())
+}
+
+// PingCtx is like Ping but aborts the request when ctx is done.
+func (j *Jira) PingCtx(ctx context.Context) (err error) {
+	// serverInfo can be read anonymously, myself needs valid credentials
+	url := j.url(j.ApiPath, myself_url)
+	_, err = j.buildAndExecRequestCtx(ctx, "GET", url)
+	return
+}
+
+/*
+Returns the deployment type of the instance, DeploymentCloud or
+DeploymentServer, Data Center instances reporting themselves as servers.
+It is only requested once, later calls using the cached value.
+
+	GET http://example.com:8080/jira/rest/api/2/serverInfo
+
+Usage
+
+	deployment, err := jira.DeploymentType()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(deployment)
+*/
+func (j *Jira) DeploymentType() (string, error) {
+	return j.DeploymentTypeCtx(context.Background())
+}
+
+// DeploymentTypeCtx is like DeploymentType but aborts the request when ctx
+// is done.
+func (j *Jira) DeploymentTypeCtx(ctx context.Context) (string, error) {
+	j.deploymentMu.Lock()
+	defer j.deploymentMu.Unlock()
+
+	if j.deploymentType != "" {
+		return j.deploymentType, nil
+	}
+
+	info, err := j.ServerInfoCtx(ctx)
+	if err != nil {
+		var timeErr *TimeParseError
+		if !errors.As(err, &timeErr) {
+			return "", err
+		}
+	}
+
+	// older servers don't report their deployment type
+	j.deploymentType = info.DeploymentType
+	if j.deploymentType == "" {
+		j.deploymentType = DeploymentServer
+	}
+
+	return j.deploymentType, nil
+}
+
+/*
+Tells whether the instance is hosted by Atlassian, which identifies users
+by account id and uses ADF documents for rich text. See DeploymentType.
+
+Usage
+
+	cloud, err := jira.IsCloud()
+	if err == nil && cloud {
+		user, err = jira.UserByAccountId(accountId)
+	}
+*/
+func (j *Jira) IsCloud() (bool, error) {
+	return j.IsCloudCtx(context.Background())
+}
+
+// IsCloudCtx is like IsCloud but aborts the request when ctx is done.
+func (j *Jira) IsCloudCtx(ctx context.Context) (bool, error) {
+	deployment, err := j.DeploymentTypeCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(deployment, DeploymentCloud), nil
+}
@@ -0,0 +1,60 @@
+package gojira
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Logger receives a line per request and per response when set on
+// Jira.Logger. *log.Logger implements it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redactedHeaders hold credentials and are masked before requests are
+// handed to hooks
+var redactedHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+
+// traceRequest logs req, without its query which may hold JQL or
+// identifiers, and passes a copy of it, credentials redacted, to
+// the request hook
+func (j *Jira) traceRequest(req *http.Request) {
+	if j.Logger != nil {
+		j.Logger.Printf("jira: %s %s", req.Method, redactedUrl(req.URL))
+	}
+
+	if j.RequestHook != nil {
+		traced := req.Clone(req.Context())
+		for _, name := range redactedHeaders {
+			if traced.Header.Get(name) != "" {
+				traced.Header.Set(name, "REDACTED")
+			}
+		}
+		j.RequestHook(traced)
+	}
+}
+
+// traceResponse logs the outcome of req, started at start, and passes
+// resp to the response hook when the request got one
+func (j *Jira) traceResponse(req *http.Request, resp *http.Response, err error, start time.Time) {
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	if j.Logger != nil {
+		if err != nil {
+			// *url.Error repeats the whole url, query included
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				err = urlErr.Err
+			}
+			j.Logger.Printf("jira: %s %s failed after %s: %s", req.Method, redactedUrl(req.URL), elapsed, err)
+		} else {
+			j.Logger.Printf("jira: %s %s -> %s in %s", req.Method, redactedUrl(req.URL), resp.Status, elapsed)
+		}
+	}
+
+	if j.ResponseHook != nil && resp != nil {
+		j.ResponseHook(resp)
+	}
+}
@@ -0,0 +1,30 @@
+package gojira
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLoggerRedactsQuery(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"issues":[]}`))
+	})
+	var buffer bytes.Buffer
+	jira.Logger = log.New(&buffer, "", 0)
+
+	if _, err := jira.Search(`reporter = "jsmith@example.com"`, 0, 50, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	logged := buffer.String()
+	if !strings.Contains(logged, "GET ") || !strings.Contains(logged, "/rest/api/2/search") {
+		t.Errorf("expected the request to be logged, got %q", logged)
+	}
+	if strings.Contains(logged, "jsmith") || strings.Contains(logged, "?") {
+		t.Errorf("the query was logged: %q", logged)
+	}
+}
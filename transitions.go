@@ -0,0 +1,241 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	transitions_url = "/transitions"
+)
+
+// Transition is a workflow transition available on an issue, To being
+// the status the issue ends up in once the transition is performed.
+type Transition struct {
+	Id   string       `json:"id"`
+	Name string       `json:"name"`
+	To   *IssueStatus `json:"to"`
+}
+
+type transitionList struct {
+	Transitions []*Transition `json:"transitions"`
+}
+
+/*
+Returns the transitions available on an issue for the current user,
+given its current status.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/transitions
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	transitions, err := jira.Transitions("FOO-12")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, transition := range transitions {
+		fmt.Printf("%s: %s -> %s\n", transition.Id, transition.Name, transition.To.Name)
+	}
+*/
+func (j *Jira) Transitions(issueKey string) (transitions []*Transition, err error) {
+	return j.TransitionsCtx(context.Background(), issueKey)
+}
+
+// TransitionsCtx is like Transitions but aborts the request when ctx is
+// done.
+func (j *Jira) TransitionsCtx(ctx context.Context, issueKey string) (transitions []*Transition, err error) {
+	url := j.url(j.ApiPath, issue_url+"/%s"+transitions_url, issueKey)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	list := transitionList{}
+	err = json.Unmarshal(contents, &list)
+	if err != nil {
+		return
+	}
+
+	transitions = list.Transitions
+	return
+}
+
+/*
+Performs a transition on an issue, optionally setting fields of the
+transition screen. A 400 *ErrorResponse holding the offending fields is
+returned when a field required by the transition is missing.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/transitions
+
+Parameters
+
+	issueKey     string The issue id or key
+	transitionID string The id of the transition, as returned by Transitions
+	fields       map    Fields to set during the transition, may be nil
+
+Usage
+
+	err := jira.DoTransition("FOO-12", "31", map[string]interface{}{
+		"resolution": map[string]string{"name": "Fixed"},
+	})
+*/
+func (j *Jira) DoTransition(issueKey, transitionID string, fields map[string]interface{}) (err error) {
+	return j.DoTransitionCtx(context.Background(), issueKey, transitionID, fields)
+}
+
+// DoTransitionCtx is like DoTransition but aborts the request when ctx is
+// done.
+func (j *Jira) DoTransitionCtx(ctx context.Context, issueKey, transitionID string, fields map[string]interface{}) (err error) {
+	if transitionID == "" {
+		return errors.New("transition id is required to transition an issue")
+	}
+
+	payload := map[string]interface{}{
+		"transition": map[string]string{"id": transitionID},
+	}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+transitions_url, issueKey)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	return
+}
+
+/*
+Performs the transition leading an issue to the given status, so callers
+don't need to know the transition ids, which differ between workflows.
+The status name is matched case-insensitively against the target status
+of each available transition.
+
+	GET  http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/transitions
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/transitions
+
+Parameters
+
+	issueKey   string The issue id or key
+	statusName string The name of the status to move the issue to, e.g. "Done"
+	fields     map    Fields to set during the transition, may be nil
+
+Usage
+
+	err := jira.TransitionByName("FOO-12", "done", nil)
+*/
+func (j *Jira) TransitionByName(issueKey, statusName string, fields map[string]interface{}) (err error) {
+	return j.TransitionByNameCtx(context.Background(), issueKey, statusName, fields)
+}
+
+// TransitionByNameCtx is like TransitionByName but aborts the requests when
+// ctx is done.
+func (j *Jira) TransitionByNameCtx(ctx context.Context, issueKey, statusName string, fields map[string]interface{}) (err error) {
+	transitions, err := j.TransitionsCtx(ctx, issueKey)
+	if err != nil {
+		return
+	}
+
+	available := make([]string, 0, len(transitions))
+	for _, transition := range transitions {
+		if transition.To == nil {
+			continue
+		}
+		if strings.EqualFold(transition.To.Name, statusName) {
+			return j.DoTransitionCtx(ctx, issueKey, transition.Id, fields)
+		}
+		available = append(available, fmt.Sprintf("%q (%s)", transition.To.Name, transition.Name))
+	}
+
+	if len(available) == 0 {
+		return fmt.Errorf("no transition is available on %s", issueKey)
+	}
+	return fmt.Errorf("no transition of %s leads to status %q, available: %s",
+		issueKey, statusName, strings.Join(available, ", "))
+}
+
+// BulkResult reports the outcome of BulkTransition for each issue,
+// Succeeded holding the keys which were transitioned and Failed the error
+// of every other key.
+type BulkResult struct {
+	Succeeded []string
+	Failed    map[string]error
+}
+
+/*
+Transitions several issues, looking the transition up for each one since
+their workflows may differ. The transition name is matched
+case-insensitively against the name of the transition, then against its
+target status. Failures don't stop the remaining issues, they are
+reported per key in the result.
+
+	GET  http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/transitions
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/transitions
+
+Parameters
+
+	issueKeys      []string The keys of the issues to transition
+	transitionName string   The name of the transition or of its target status
+	fields         map      Fields to set during each transition, may be nil
+
+Usage
+
+	result, err := jira.BulkTransition(keys, "Close Issue", nil)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for key, err := range result.Failed {
+		fmt.Println(key, err)
+	}
+*/
+func (j *Jira) BulkTransition(issueKeys []string, transitionName string, fields map[string]interface{}) (result BulkResult, err error) {
+	return j.BulkTransitionCtx(context.Background(), issueKeys, transitionName, fields)
+}
+
+// BulkTransitionCtx is like BulkTransition but aborts the requests when ctx
+// is done.
+func (j *Jira) BulkTransitionCtx(ctx context.Context, issueKeys []string, transitionName string, fields map[string]interface{}) (result BulkResult, err error) {
+	if transitionName == "" {
+		return result, errors.New("transition name is required to transition issues")
+	}
+
+	result.Failed = make(map[string]error)
+	for _, issueKey := range issueKeys {
+		if err := j.transitionNamed(ctx, issueKey, transitionName, fields); err != nil {
+			result.Failed[issueKey] = err
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, issueKey)
+	}
+
+	return result, nil
+}
+
+// transitionNamed performs the transition of an issue called name, or
+// else the one leading to the status called name
+func (j *Jira) transitionNamed(ctx context.Context, issueKey, name string, fields map[string]interface{}) error {
+	transitions, err := j.TransitionsCtx(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+
+	var byStatus *Transition
+	for _, transition := range transitions {
+		if strings.EqualFold(transition.Name, name) {
+			return j.DoTransitionCtx(ctx, issueKey, transition.Id, fields)
+		}
+		if byStatus == nil && transition.To != nil && strings.EqualFold(transition.To.Name, name) {
+			byStatus = transition
+		}
+	}
+
+	if byStatus == nil {
+		return fmt.Errorf("no transition %q is available on %s", name, issueKey)
+	}
+
+	return j.DoTransitionCtx(ctx, issueKey, byStatus.Id, fields)
+}
@@ -0,0 +1,26 @@
+package gojira
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+)
+
+func TestNewJiraSharesTransport(t *testing.T) {
+	first := NewJira("https://jira.example.com", "/rest/api/2", "/activity", nil)
+	second := NewJira("https://jira.example.com", "/rest/api/2", "/activity", nil)
+
+	if first.Client.(*http.Client).Transport != second.Client.(*http.Client).Transport {
+		t.Fatal("expected clients created by NewJira to share their transport")
+	}
+
+	if err := first.SetTLSConfig(&tls.Config{ServerName: "jira.internal"}); err != nil {
+		t.Fatal(err)
+	}
+	if first.Client.(*http.Client).Transport == sharedTransport {
+		t.Error("expected SetTLSConfig to give the client a transport of its own")
+	}
+	if second.Client.(*http.Client).Transport != sharedTransport || (sharedTransport.TLSClientConfig != nil && sharedTransport.TLSClientConfig.ServerName != "") {
+		t.Error("SetTLSConfig changed the shared transport")
+	}
+}
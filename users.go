@@ -1,13 +1,15 @@
 package gojira
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"strconv"
 )
 
 const (
-	user_url        = "/user"
-	user_search_url = "/user/search"
+	user_url         = "/user"
+	user_search_url  = "/user/search"
+	group_member_url = "/group/member"
 	// http://example.com:8080/jira/rest/api/2/user/assignable/multiProjectSearch [GET]
 	// http://example.com:8080/jira/rest/api/2/user/assignable/search [GET]
 	// http://example.com:8080/jira/rest/api/2/user/avatar [POST, PUT]
@@ -18,42 +20,44 @@ const (
 	// http://example.com:8080/jira/rest/api/2/user/viewissue/search [GET]
 )
 
+// User is either a Jira Server user, identified by Name and Key, or a
+// Jira Cloud user, identified by AccountId.
 type User struct {
 	Self         string            `json:"self"`
+	AccountId    string            `json:"accountId"`
+	AccountType  string            `json:"accountType"`
+	Key          string            `json:"key"`
 	Name         string            `json:"name"`
 	EmailAddress string            `json:"emailAddress"`
 	DisplayName  string            `json:"displayName"`
 	Active       bool              `json:"active"`
 	TimeZone     string            `json:"timeZone"`
+	Locale       string            `json:"locale"`
 	AvatarUrls   map[string]string `json:"avatarUrls"`
+	Groups       *UserGroups       `json:"groups"`
 	Expand       string            `json:"expand"`
-	// "groups": {
-	//     "size": 3,
-	//     "items": [
-	//         {
-	//             "name": "jira-user",
-	//             "self": "http://www.example.com/jira/rest/api/2/group?groupname=jira-user"
-	//         },
-	//         {
-	//             "name": "jira-admin",
-	//             "self": "http://www.example.com/jira/rest/api/2/group?groupname=jira-admin"
-	//         },
-	//         {
-	//             "name": "important",
-	//             "self": "http://www.example.com/jira/rest/api/2/group?groupname=important"
-	//         }
-	//     ]
-	// }
+}
+
+// UserGroups is only returned when the user groups are expanded,
+// e.g. with a "expand=groups" param.
+type UserGroups struct {
+	Size  int          `json:"size"`
+	Items []*UserGroup `json:"items"`
+}
+
+type UserGroup struct {
+	Name string `json:"name"`
+	Self string `json:"self"`
 }
 
 /*
 Returns a user. This resource cannot be accessed anonymously.
 
-    GET http://example.com:8080/jira/rest/api/2/user?username=USERNAME
+	GET http://example.com:8080/jira/rest/api/2/user?username=USERNAME
 
 Parameters
 
-    username string The username
+	username string The username
 
 Usage
 
@@ -63,13 +67,55 @@ Usage
 	}
 	fmt.Printf("%+v\n", user)
 */
-func (j *Jira) User(username string) (user *User, err error) {
-	url := j.BaseUrl + j.ApiPath + user_url + "?username=" + username
-	contents, err := j.buildAndExecRequest("GET", url)
+func (j *Jira) User(username string) (*User, error) {
+	return j.UserCtx(context.Background(), username)
+}
+
+// UserCtx is like User but aborts the request when ctx is done.
+func (j *Jira) UserCtx(ctx context.Context, username string) (user *User, err error) {
+	url := j.url(j.ApiPath, user_url) + "?" + Params{"username": username}.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
 	if err != nil {
 		return
 	}
-	
+
+	user = &User{}
+
+	err = json.Unmarshal(contents, user)
+	return
+}
+
+/*
+Returns a user by its account id, the identifier Jira Cloud uses in place
+of usernames. This resource cannot be accessed anonymously.
+
+	GET http://example.com:8080/jira/rest/api/2/user?accountId=ACCOUNTID
+
+Parameters
+
+	accountId string The account id of the user
+
+Usage
+
+	user, err := jira.UserByAccountId("5b10ac8d82e05b22cc7d4ef5")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(user.DisplayName)
+*/
+func (j *Jira) UserByAccountId(accountId string) (user *User, err error) {
+	return j.UserByAccountIdCtx(context.Background(), accountId)
+}
+
+// UserByAccountIdCtx is like UserByAccountId but aborts the request when ctx
+// is done.
+func (j *Jira) UserByAccountIdCtx(ctx context.Context, accountId string) (user *User, err error) {
+	url := j.url(j.ApiPath, user_url) + "?" + Params{"accountId": accountId}.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
 	user = &User{}
 
 	err = json.Unmarshal(contents, user)
@@ -79,28 +125,164 @@ func (j *Jira) User(username string) (user *User, err error) {
 /*
 Returns a list of users that match the search string. This resource cannot be accessed anonymously.
 
-	GET http://example.com:8080/jira/rest/api/2/user/search
+	GET http://example.com:8080/jira/rest/api/2/user/search?query=QUERY
 
 Parameters
 
-	username        string  A query string used to search username, name or e-mail address
-	startAt         int     The index of the first user to return (0-based)
-	maxResults      int     The maximum number of users to return (defaults to 50).
-				   	        The maximum allowed value is 1000.
-				   	        If you specify a value that is higher than this number,
-				   	        your search results will be truncated.
-	includeActive   boolean If true, then active users are included in the results (default true)
-	includeInactive boolean If true, then inactive users are included in the results (default false)
+	query      string A query string matched against the display name and e-mail address
+	maxResults int    The maximum number of users to return (defaults to 50 when zero).
+	                  The maximum allowed value is 1000.
+	                  If you specify a value that is higher than this number,
+	                  your search results will be truncated.
+
+Usage
 
+	users, err := jira.SearchUsers("john", 10)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, user := range users {
+		fmt.Println(user.DisplayName, user.EmailAddress)
+	}
 */
-func (j *Jira) SearchUser(username string, startAt int, maxResults int, includeActive bool, includeInactive bool) (err error) {
-	url := j.BaseUrl + j.ApiPath + user_url + "?username=" + username
-	contents, err := j.buildAndExecRequest("GET", url)
+func (j *Jira) SearchUsers(query string, maxResults int) (users []*User, err error) {
+	return j.SearchUsersCtx(context.Background(), query, maxResults)
+}
+
+// SearchUsersCtx is like SearchUsers but aborts the request when ctx is
+// done.
+func (j *Jira) SearchUsersCtx(ctx context.Context, query string, maxResults int) (users []*User, err error) {
+	params := Params{"query": query}
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+
+	url := j.url(j.ApiPath, user_search_url) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
 	if err != nil {
 		return
 	}
 
-	fmt.Println(string(contents))
+	users = []*User{}
+
+	err = json.Unmarshal(contents, &users)
+	return
+}
+
+// SearchUser runs a user search and only reports whether it failed.
+//
+// Deprecated: use SearchUsers, which returns the matching users.
+func (j *Jira) SearchUser(username string, startAt int, maxResults int, includeActive bool, includeInactive bool) error {
+	return j.SearchUserCtx(context.Background(), username, startAt, maxResults, includeActive, includeInactive)
+}
+
+// SearchUserCtx is like SearchUser but aborts the request when ctx is done.
+//
+// Deprecated: use SearchUsers, which returns the matching users.
+func (j *Jira) SearchUserCtx(ctx context.Context, username string, startAt int, maxResults int, includeActive bool, includeInactive bool) error {
+	params := Params{
+		"username":        username,
+		"startAt":         strconv.Itoa(startAt),
+		"includeActive":   strconv.FormatBool(includeActive),
+		"includeInactive": strconv.FormatBool(includeInactive),
+	}
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+
+	url := j.url(j.ApiPath, user_search_url) + "?" + params.Query()
+	_, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	return err
+}
+
+/*
+Returns the user the client is authenticated as, e.g. to check the
+credentials belong to the expected account. This resource cannot be
+accessed anonymously.
+
+	GET http://example.com:8080/jira/rest/api/2/myself
+
+Usage
+
+	me, err := jira.Myself()
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(me.AccountId, me.DisplayName, me.EmailAddress, me.TimeZone)
+*/
+func (j *Jira) Myself() (user *User, err error) {
+	return j.MyselfCtx(context.Background())
+}
+
+// MyselfCtx is like Myself but aborts the request when ctx is done.
+func (j *Jira) MyselfCtx(ctx context.Context) (user *User, err error) {
+	url := j.url(j.ApiPath, myself_url)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	user = &User{}
+
+	err = json.Unmarshal(contents, user)
+	return
+}
+
+/*
+Returns a page of the members of a group. Jira Cloud also identifies
+groups by id, the name is accepted by both Cloud and Server.
+This resource cannot be accessed anonymously.
+
+	GET http://example.com:8080/jira/rest/api/2/group/member?groupname=GROUPNAME
+
+Parameters
+
+	groupName  string The name of the group
+	startAt    int    The index of the first member to return (0-based)
+	maxResults int    The maximum number of members to return, at most 50
+
+Usage
+
+	members, pagination, err := jira.GroupMembers("jira-administrators", 0, 50)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, member := range members {
+		fmt.Println(member.DisplayName, member.Active)
+	}
+	fmt.Println(pagination.PageCount)
+*/
+func (j *Jira) GroupMembers(groupName string, startAt int, maxResults int) (users []*User, pagination *Pagination, err error) {
+	return j.GroupMembersCtx(context.Background(), groupName, startAt, maxResults)
+}
+
+// GroupMembersCtx is like GroupMembers but aborts the request when ctx is
+// done.
+func (j *Jira) GroupMembersCtx(ctx context.Context, groupName string, startAt int, maxResults int) (users []*User, pagination *Pagination, err error) {
+	params := Params{
+		"groupname":  groupName,
+		"startAt":    strconv.Itoa(startAt),
+		"maxResults": strconv.Itoa(maxResults),
+	}
+
+	url := j.url(j.ApiPath, group_member_url) + "?" + params.Query()
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	page := pagedValues{}
+	err = json.Unmarshal(contents, &page)
+	if err != nil {
+		return
+	}
+
+	users = []*User{}
+	err = page.decode(&users)
+	if err != nil {
+		return
+	}
 
+	pagination = page.pagination()
 	return
 }
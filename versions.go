@@ -0,0 +1,189 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	version_url  = "/version"
+	versions_url = "/versions"
+)
+
+// ErrVersionExists is returned by CreateVersion when the project already
+// has a version with the same name.
+var ErrVersionExists = errors.New("a version with this name already exists")
+
+type Version struct {
+	Self          string    `json:"self"`
+	Id            string    `json:"id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Archived      bool      `json:"archived"`
+	Released      bool      `json:"released"`
+	ReleaseDate   string    `json:"releaseDate"`
+	ReleaseDateAt time.Time `json:"-"`
+	ProjectId     int       `json:"projectId"`
+}
+
+// parse the version release date, which has no time part
+func (v *Version) parseTimes() (err error) {
+	v.ReleaseDateAt, err = parseJiraTime(v.ReleaseDate)
+	if err != nil {
+		err = &TimeParseError{Field: "version " + v.Name + " release date", Value: v.ReleaseDate}
+	}
+
+	return
+}
+
+/*
+Returns the versions of a project, released or not.
+
+	GET http://example.com:8080/jira/rest/api/2/project/{projectIdOrKey}/versions
+
+Parameters
+
+	projectKey string The project id or key
+
+Usage
+
+	versions, err := jira.ProjectVersions("FOO")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, version := range versions {
+		if !version.Released {
+			fmt.Println(version.Name, version.ReleaseDateAt)
+		}
+	}
+*/
+func (j *Jira) ProjectVersions(projectKey string) (versions []*Version, err error) {
+	return j.ProjectVersionsCtx(context.Background(), projectKey)
+}
+
+// ProjectVersionsCtx is like ProjectVersions but aborts the request when ctx
+// is done.
+func (j *Jira) ProjectVersionsCtx(ctx context.Context, projectKey string) (versions []*Version, err error) {
+	url := j.url(j.ApiPath, project_url+"/%s"+versions_url, projectKey)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	versions = []*Version{}
+	err = json.Unmarshal(contents, &versions)
+	if err != nil {
+		return
+	}
+
+	for _, version := range versions {
+		if parseErr := version.parseTimes(); parseErr != nil && err == nil {
+			err = parseErr
+		}
+	}
+
+	return
+}
+
+/*
+Creates a new unreleased version in a project.
+
+	POST http://example.com:8080/jira/rest/api/2/version
+
+Parameters
+
+	projectKey  string The key of the project
+	name        string The name of the version, unique in the project
+	description string The description of the version, may be empty
+
+Usage
+
+	version, err := jira.CreateVersion("FOO", "1.2.0", "Spring release")
+	if errors.Is(err, gojira.ErrVersionExists) {
+		fmt.Println("already cut")
+	}
+*/
+func (j *Jira) CreateVersion(projectKey, name, description string) (version *Version, err error) {
+	return j.CreateVersionCtx(context.Background(), projectKey, name, description)
+}
+
+// CreateVersionCtx is like CreateVersion but aborts the request when ctx is
+// done.
+func (j *Jira) CreateVersionCtx(ctx context.Context, projectKey, name, description string) (version *Version, err error) {
+	if name == "" {
+		return nil, errors.New("name is required to create a version")
+	}
+
+	payload := map[string]interface{}{
+		"project": projectKey,
+		"name":    name,
+	}
+	if description != "" {
+		payload["description"] = description
+	}
+
+	url := j.url(j.ApiPath, version_url)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	if err != nil {
+		return nil, versionExistsError(err)
+	}
+
+	version = &Version{}
+	err = json.Unmarshal(contents, version)
+	if err != nil {
+		return
+	}
+
+	err = version.parseTimes()
+	return
+}
+
+// depending on its version jira reports duplicate names either with
+// 409 Conflict or with a 400 error on the name field
+func versionExistsError(err error) error {
+	var errResponse *ErrorResponse
+	if errors.As(err, &errResponse) && strings.Contains(strings.ToLower(errResponse.Errors["name"]), "already exists") {
+		return statusError(err, ErrVersionExists, http.StatusConflict, http.StatusBadRequest)
+	}
+
+	return statusError(err, ErrVersionExists, http.StatusConflict)
+}
+
+/*
+Marks a version as released.
+
+	PUT http://example.com:8080/jira/rest/api/2/version/{id}
+
+Parameters
+
+	versionId   string    The id of the version
+	releaseDate time.Time The release date, only its day part is kept
+
+Usage
+
+	err := jira.ReleaseVersion(version.Id, time.Now())
+*/
+func (j *Jira) ReleaseVersion(versionId string, releaseDate time.Time) (err error) {
+	return j.ReleaseVersionCtx(context.Background(), versionId, releaseDate)
+}
+
+// ReleaseVersionCtx is like ReleaseVersion but aborts the request when ctx
+// is done.
+func (j *Jira) ReleaseVersionCtx(ctx context.Context, versionId string, releaseDate time.Time) (err error) {
+	if versionId == "" {
+		return errors.New("version id is required to release a version")
+	}
+
+	payload := map[string]interface{}{
+		"released":    true,
+		"releaseDate": releaseDate.Format(dayLayout),
+	}
+
+	url := j.url(j.ApiPath, version_url+"/%s", versionId)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "PUT", url, payload)
+	return
+}
@@ -0,0 +1,100 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	votes_url = "/votes"
+)
+
+// ErrVotingDisabled is returned by the vote methods when jira answers 404,
+// which it does when voting is disabled as well as for unknown issues.
+var ErrVotingDisabled = errors.New("voting is disabled or the issue does not exist")
+
+type issueVotes struct {
+	Self     string  `json:"self"`
+	Votes    int     `json:"votes"`
+	HasVoted bool    `json:"hasVoted"`
+	Voters   []*User `json:"voters"`
+}
+
+/*
+Returns the number of votes of an issue and whether the current user voted for it.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/votes
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	count, hasVoted, err := jira.Votes("FOO-12")
+	if errors.Is(err, gojira.ErrVotingDisabled) {
+		fmt.Println("no votes here")
+	}
+*/
+func (j *Jira) Votes(issueKey string) (count int, hasVoted bool, err error) {
+	return j.VotesCtx(context.Background(), issueKey)
+}
+
+// VotesCtx is like Votes but aborts the request when ctx is done.
+func (j *Jira) VotesCtx(ctx context.Context, issueKey string) (count int, hasVoted bool, err error) {
+	url := j.url(j.ApiPath, issue_url+"/%s"+votes_url, issueKey)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return 0, false, statusError(err, ErrVotingDisabled, http.StatusNotFound)
+	}
+
+	votes := issueVotes{}
+	err = json.Unmarshal(contents, &votes)
+	if err != nil {
+		return
+	}
+
+	return votes.Votes, votes.HasVoted, nil
+}
+
+/*
+Casts the vote of the current user for an issue.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/votes
+
+Usage
+
+	err := jira.Vote("FOO-12")
+*/
+func (j *Jira) Vote(issueKey string) error {
+	return j.VoteCtx(context.Background(), issueKey)
+}
+
+// VoteCtx is like Vote but aborts the request when ctx is done.
+func (j *Jira) VoteCtx(ctx context.Context, issueKey string) error {
+	url := j.url(j.ApiPath, issue_url+"/%s"+votes_url, issueKey)
+	_, err := j.buildAndExecRequestCtx(ctx, "POST", url)
+	return statusError(err, ErrVotingDisabled, http.StatusNotFound)
+}
+
+/*
+Removes the vote of the current user from an issue.
+
+	DELETE http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/votes
+
+Usage
+
+	err := jira.Unvote("FOO-12")
+*/
+func (j *Jira) Unvote(issueKey string) error {
+	return j.UnvoteCtx(context.Background(), issueKey)
+}
+
+// UnvoteCtx is like Unvote but aborts the request when ctx is done.
+func (j *Jira) UnvoteCtx(ctx context.Context, issueKey string) error {
+	url := j.url(j.ApiPath, issue_url+"/%s"+votes_url, issueKey)
+	_, err := j.buildAndExecRequestCtx(ctx, "DELETE", url)
+	return statusError(err, ErrVotingDisabled, http.StatusNotFound)
+}
@@ -0,0 +1,168 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	watchers_url = "/watchers"
+)
+
+// Watches summarizes the watchers of an issue, as found in its fields.
+type Watches struct {
+	Self       string `json:"self"`
+	WatchCount int    `json:"watchCount"`
+	IsWatching bool   `json:"isWatching"`
+}
+
+type watcherList struct {
+	Self       string  `json:"self"`
+	IsWatching bool    `json:"isWatching"`
+	WatchCount int     `json:"watchCount"`
+	Watchers   []*User `json:"watchers"`
+}
+
+/*
+Returns the users watching an issue. A 403 *ErrorResponse is returned when
+the user is not allowed to view the watchers of the issue.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/watchers
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	watchers, err := jira.Watchers("FOO-12")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, watcher := range watchers {
+		fmt.Println(watcher.DisplayName)
+	}
+*/
+func (j *Jira) Watchers(issueKey string) (watchers []*User, err error) {
+	return j.WatchersCtx(context.Background(), issueKey)
+}
+
+// WatchersCtx is like Watchers but aborts the request when ctx is done.
+func (j *Jira) WatchersCtx(ctx context.Context, issueKey string) (watchers []*User, err error) {
+	url := j.url(j.ApiPath, issue_url+"/%s"+watchers_url, issueKey)
+	contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+	if err != nil {
+		return
+	}
+
+	list := watcherList{}
+	err = json.Unmarshal(contents, &list)
+	if err != nil {
+		return
+	}
+
+	watchers = list.Watchers
+	return
+}
+
+/*
+Adds a user to the watchers of an issue. Jira expects the body to be the
+account id as a bare json string rather than an object.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/watchers
+
+Parameters
+
+	issueKey  string The issue id or key
+	accountId string The account id of the user to add
+
+Usage
+
+	err := jira.AddWatcher("FOO-12", "5b10ac8d82e05b22cc7d4ef5")
+*/
+func (j *Jira) AddWatcher(issueKey, accountId string) (err error) {
+	return j.AddWatcherCtx(context.Background(), issueKey, accountId)
+}
+
+// AddWatcherCtx is like AddWatcher but aborts the request when ctx is done.
+func (j *Jira) AddWatcherCtx(ctx context.Context, issueKey, accountId string) (err error) {
+	if accountId == "" {
+		return errors.New("account id is required to add a watcher")
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+watchers_url, issueKey)
+	_, err = j.buildAndExecJSONRequestCtx(ctx, "POST", url, accountId)
+	return
+}
+
+/*
+Removes a user from the watchers of an issue, the user being given as a
+query parameter.
+
+	DELETE http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/watchers?accountId=ACCOUNTID
+
+Parameters
+
+	issueKey  string The issue id or key
+	accountId string The account id of the user to remove
+
+Usage
+
+	err := jira.RemoveWatcher("FOO-12", "5b10ac8d82e05b22cc7d4ef5")
+*/
+func (j *Jira) RemoveWatcher(issueKey, accountId string) (err error) {
+	return j.RemoveWatcherCtx(context.Background(), issueKey, accountId)
+}
+
+// RemoveWatcherCtx is like RemoveWatcher but aborts the request when ctx is
+// done.
+func (j *Jira) RemoveWatcherCtx(ctx context.Context, issueKey, accountId string) (err error) {
+	if accountId == "" {
+		return errors.New("account id is required to remove a watcher")
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+watchers_url, issueKey) + "?" + Params{"accountId": accountId}.Query()
+	_, err = j.buildAndExecRequestCtx(ctx, "DELETE", url)
+	return
+}
+
+/*
+Returns the number of watchers of an issue and whether the current user
+watches it, read from the issue fields rather than from the list of
+watchers.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}?fields=watches
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	count, watching, err := jira.WatchCount("FOO-12")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println(count, watching)
+*/
+func (j *Jira) WatchCount(issueKey string) (count int, isWatching bool, err error) {
+	return j.WatchCountCtx(context.Background(), issueKey)
+}
+
+// WatchCountCtx is like WatchCount but aborts the request when ctx is done.
+func (j *Jira) WatchCountCtx(ctx context.Context, issueKey string) (count int, isWatching bool, err error) {
+	issue, err := j.IssueWithCtx(ctx, issueKey, WithFields("watches"))
+	if err != nil {
+		var timeErr *TimeParseError
+		if !errors.As(err, &timeErr) {
+			return
+		}
+	}
+
+	if issue.Fields == nil || issue.Fields.Watches == nil {
+		return 0, false, errors.New("issue " + issueKey + " has no watches field, watching may be disabled")
+	}
+
+	return issue.Fields.Watches.WatchCount, issue.Fields.Watches.IsWatching, nil
+}
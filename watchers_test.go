@@ -0,0 +1,21 @@
+package gojira
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWatchCountUnexpectedTimestamp(t *testing.T) {
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10002","key":"FOO-12","fields":{"updated":"12/Apr/13 9:31 AM","watches":{"watchCount":3,"isWatching":true}}}`))
+	})
+
+	count, watching, err := jira.WatchCount("FOO-12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 || !watching {
+		t.Errorf("got %d watchers, watching %v, want 3 and true", count, watching)
+	}
+}
@@ -0,0 +1,187 @@
+package gojira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	worklog_url = "/worklog"
+)
+
+type Worklog struct {
+	Self             string    `json:"self"`
+	Id               string    `json:"id"`
+	IssueId          string    `json:"issueId"`
+	Author           *User     `json:"author"`
+	UpdateAuthor     *User     `json:"updateAuthor"`
+	Comment          string    `json:"comment"`
+	Created          string    `json:"created"`
+	CreatedAt        time.Time `json:"-"`
+	Updated          string    `json:"updated"`
+	UpdatedAt        time.Time `json:"-"`
+	Started          string    `json:"started"`
+	StartedAt        time.Time `json:"-"`
+	TimeSpent        string    `json:"timeSpent"`
+	TimeSpentSeconds int       `json:"timeSpentSeconds"`
+	// CommentADF holds the comment document with version 3 of the api,
+	// Comment then being its plain text, see ADFToPlainText.
+	CommentADF json.RawMessage `json:"-"`
+}
+
+func (w *Worklog) UnmarshalJSON(data []byte) error {
+	// the comment is either a string or an ADF document, see IssueFields
+	type worklog Worklog
+	fields := struct {
+		*worklog
+		Comment json.RawMessage `json:"comment"`
+	}{worklog: (*worklog)(w)}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var err error
+	w.Comment, w.CommentADF, err = textOrADF(fields.Comment)
+	return err
+}
+
+type worklogList struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Worklogs   []*Worklog `json:"worklogs"`
+}
+
+// parse the worklog timestamps, issueKey being used to report failures
+func (w *Worklog) parseTimes(issueKey string) (err error) {
+	times := []struct {
+		field string
+		value string
+		t     *time.Time
+	}{
+		{"worklog created", w.Created, &w.CreatedAt},
+		{"worklog updated", w.Updated, &w.UpdatedAt},
+		{"worklog started", w.Started, &w.StartedAt},
+	}
+
+	for _, tt := range times {
+		t, parseErr := parseJiraTime(tt.value)
+		if parseErr != nil && err == nil {
+			err = &TimeParseError{Issue: issueKey, Field: tt.field, Value: tt.value}
+		}
+		*tt.t = t
+	}
+
+	return
+}
+
+/*
+Returns the worklogs of an issue. Jira returns them a page at a time, the
+pages being walked until all the worklogs are fetched.
+
+	GET http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/worklog?startAt=0
+
+Parameters
+
+	issueKey string The issue id or key
+
+Usage
+
+	worklogs, err := jira.Worklogs("FOO-12")
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	for _, worklog := range worklogs {
+		fmt.Println(worklog.Author.DisplayName, worklog.StartedAt, worklog.TimeSpent)
+	}
+*/
+func (j *Jira) Worklogs(issueKey string) (worklogs []*Worklog, err error) {
+	return j.WorklogsCtx(context.Background(), issueKey)
+}
+
+// WorklogsCtx is like Worklogs but aborts the requests when ctx is done.
+func (j *Jira) WorklogsCtx(ctx context.Context, issueKey string) (worklogs []*Worklog, err error) {
+	for startAt := 0; ; {
+		url := j.url(j.ApiPath, issue_url+"/%s"+worklog_url, issueKey) + "?" + Params{"startAt": strconv.Itoa(startAt)}.Query()
+		contents, err := j.buildAndExecRequestCtx(ctx, "GET", url)
+		if err != nil {
+			return nil, err
+		}
+
+		list := worklogList{}
+		err = json.Unmarshal(contents, &list)
+		if err != nil {
+			return nil, err
+		}
+
+		worklogs = append(worklogs, list.Worklogs...)
+
+		// jira server returns every worklog at once, move forward by
+		// what was actually returned
+		startAt = list.StartAt + len(list.Worklogs)
+		if len(list.Worklogs) == 0 || startAt >= list.Total {
+			break
+		}
+	}
+
+	for _, worklog := range worklogs {
+		if parseErr := worklog.parseTimes(issueKey); parseErr != nil && err == nil {
+			err = parseErr
+		}
+	}
+
+	return
+}
+
+/*
+Logs time spent on an issue and returns the created worklog.
+
+	POST http://example.com:8080/jira/rest/api/2/issue/{issueIdOrKey}/worklog
+
+Parameters
+
+	issueKey         string    The issue id or key
+	timeSpentSeconds int       The time spent, in seconds
+	started          time.Time When the work started
+	comment          string    A comment about the work, may be empty
+
+Usage
+
+	worklog, err := jira.AddWorklog("FOO-12", 90*60, time.Now().Add(-90*time.Minute), "Pairing on the fix")
+*/
+func (j *Jira) AddWorklog(issueKey string, timeSpentSeconds int, started time.Time, comment string) (worklog *Worklog, err error) {
+	return j.AddWorklogCtx(context.Background(), issueKey, timeSpentSeconds, started, comment)
+}
+
+// AddWorklogCtx is like AddWorklog but aborts the request when ctx is done.
+func (j *Jira) AddWorklogCtx(ctx context.Context, issueKey string, timeSpentSeconds int, started time.Time, comment string) (worklog *Worklog, err error) {
+	if timeSpentSeconds <= 0 {
+		return nil, errors.New("time spent must be positive to add a worklog")
+	}
+
+	payload := map[string]interface{}{
+		"timeSpentSeconds": timeSpentSeconds,
+		"started":          started.Format(dateLayout),
+	}
+	if comment != "" {
+		payload["comment"] = j.richText(comment)
+	}
+
+	url := j.url(j.ApiPath, issue_url+"/%s"+worklog_url, issueKey)
+	contents, err := j.buildAndExecJSONRequestCtx(ctx, "POST", url, payload)
+	if err != nil {
+		return
+	}
+
+	worklog = &Worklog{}
+	err = json.Unmarshal(contents, worklog)
+	if err != nil {
+		return
+	}
+
+	err = worklog.parseTimes(issueKey)
+	return
+}
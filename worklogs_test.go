@@ -0,0 +1,44 @@
+package gojira
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestWorklogsPages(t *testing.T) {
+	requests := 0
+	jira := newTestJira(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+
+		// pages of 2 worklogs out of 5
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":2,"total":5,"worklogs":[`, startAt)
+		for i := startAt; i < startAt+2 && i < 5; i++ {
+			if i > startAt {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id":"%d","started":"2019-02-04T10:10:12.118+0100","timeSpentSeconds":3600}`, 100+i)
+		}
+		fmt.Fprint(w, "]}")
+	})
+
+	worklogs, err := jira.Worklogs("FOO-12")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(worklogs) != 5 || requests != 3 {
+		t.Fatalf("got %d worklogs in %d requests, want 5 in 3", len(worklogs), requests)
+	}
+	for i, worklog := range worklogs {
+		if want := strconv.Itoa(100 + i); worklog.Id != want {
+			t.Errorf("worklog %d: Id = %s, want %s", i, worklog.Id, want)
+		}
+		if worklog.StartedAt.IsZero() {
+			t.Errorf("worklog %d: StartedAt not parsed", i)
+		}
+	}
+}